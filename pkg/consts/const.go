@@ -3,4 +3,5 @@ package consts
 const (
 	GotExpectedErrorFmt  = "got error: '%v' is not expected error: '%v'"
 	GotExpectedResultFmt = "got result: '%v' is not equal expected result: '%v'"
+	GotExpectedDiffFmt   = "got result is not equal expected result:\n%s"
 )
@@ -0,0 +1,10 @@
+package consts
+
+import "errors"
+
+// Errors shared by all packages of this module.
+// The packages re-export them, e.g., as slices.ErrInvalidArgument, thus errors.Is works across packages.
+var (
+	ErrInvalidArgument = errors.New("invalid argument") // there has been a problem with the provided argument
+	ErrDuplicateKey    = errors.New("duplicate key")    // a key occurred more than once where it has to be unique
+)
@@ -0,0 +1,259 @@
+package fuzzy
+
+import (
+	"github.com/rbnbr/go-utility/pkg/slices"
+	"unicode"
+)
+
+// Distance
+// Returns the edit distance between two strings, where 0 means equal.
+type Distance func(a, b string) int
+
+// Similarity
+// Returns a score in [0, 1] for how similar two strings are, where 1 means equal.
+type Similarity func(a, b string) float64
+
+// Match
+// A candidate which has been matched against a query.
+// Index is the index of the candidate in the provided slice.
+type Match struct {
+	Index int
+	Value string
+	Score float64
+}
+
+// Levenshtein
+// Returns the minimum number of rune insertions, deletions or substitutions needed to transform a into b.
+func Levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = minInt(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
+
+// DamerauLevenshtein
+// Same as Levenshtein but also allows transpositions of two adjacent runes as a single operation.
+// Implements the unrestricted variant, i.e., substrings may be edited more than once.
+func DamerauLevenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	n, m := len(ra), len(rb)
+	maxDist := n + m
+
+	// d is shifted by one in both dimensions to hold the sentinel row and column
+	d := make([][]int, n+2)
+	for i := range d {
+		d[i] = make([]int, m+2)
+	}
+	d[0][0] = maxDist
+	for i := 0; i <= n; i++ {
+		d[i+1][0] = maxDist
+		d[i+1][1] = i
+	}
+	for j := 0; j <= m; j++ {
+		d[0][j+1] = maxDist
+		d[1][j+1] = j
+	}
+
+	// last row in which each rune occurred in a
+	lastRow := make(map[rune]int)
+
+	for i := 1; i <= n; i++ {
+		lastMatchCol := 0
+		for j := 1; j <= m; j++ {
+			k := lastRow[rb[j-1]]
+			l := lastMatchCol
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+				lastMatchCol = j
+			}
+			d[i+1][j+1] = minInt(
+				d[i][j]+cost,
+				d[i+1][j]+1,
+				d[i][j+1]+1,
+				d[k][l]+(i-k-1)+1+(j-l-1),
+			)
+		}
+		lastRow[ra[i-1]] = i
+	}
+
+	return d[n+1][m+1]
+}
+
+// JaroWinkler
+// Returns the Jaro-Winkler similarity of a and b, which favours strings with a common prefix of up to four runes.
+func JaroWinkler(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) == 0 && len(rb) == 0 {
+		return 1
+	}
+	if len(ra) == 0 || len(rb) == 0 {
+		return 0
+	}
+
+	window := maxInt(len(ra), len(rb))/2 - 1
+	if window < 0 {
+		window = 0
+	}
+
+	matchedA := make([]bool, len(ra))
+	matchedB := make([]bool, len(rb))
+	matches := 0
+	for i := range ra {
+		for j := maxInt(0, i-window); j < minInt(len(rb), i+window+1); j++ {
+			if !matchedB[j] && ra[i] == rb[j] {
+				matchedA[i], matchedB[j] = true, true
+				matches++
+				break
+			}
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+
+	transpositions := 0
+	j := 0
+	for i := range ra {
+		if !matchedA[i] {
+			continue
+		}
+		for !matchedB[j] {
+			j++
+		}
+		if ra[i] != rb[j] {
+			transpositions++
+		}
+		j++
+	}
+
+	m := float64(matches)
+	jaro := (m/float64(len(ra)) + m/float64(len(rb)) + (m-float64(transpositions/2))/m) / 3
+
+	prefix := 0
+	for prefix < minInt(4, len(ra), len(rb)) && ra[prefix] == rb[prefix] {
+		prefix++
+	}
+
+	return jaro + float64(prefix)*0.1*(1-jaro)
+}
+
+// NormalizedSimilarity
+// Returns a Similarity based on distance, i.e., 1 - distance / length of the longer string in runes.
+func NormalizedSimilarity(distance Distance) Similarity {
+	return func(a, b string) float64 {
+		longest := maxInt(len([]rune(a)), len([]rune(b)))
+		if longest == 0 {
+			return 1
+		}
+		return 1 - float64(distance(a, b))/float64(longest)
+	}
+}
+
+// FoldCase
+// Returns a function which applies f to the case folded versions of a and b, i.e., compares case-insensitively.
+// Works for both Distance and Similarity functions.
+// Only simple folding of single runes is done, i.e., runes which fold to multiple runes like ß to ss are kept as is.
+// Example:
+// FoldCase(Levenshtein)("ÄrGER", "ärger") // 0
+func FoldCase[R any](f func(a, b string) R) func(a, b string) R {
+	return func(a, b string) R {
+		return f(foldString(a), foldString(b))
+	}
+}
+
+// foldString
+// Maps every rune of s to the smallest rune of its case folding orbit.
+func foldString(s string) string {
+	runes := []rune(s)
+	for i, r := range runes {
+		smallest := r
+		for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+			if f < smallest {
+				smallest = f
+			}
+		}
+		runes[i] = smallest
+	}
+	return string(runes)
+}
+
+// RankBySimilarity
+// Scores every candidate against query and returns those with a score of at least threshold, best first.
+// Candidates with the same score keep their order.
+func RankBySimilarity(candidates []string, query string, similarity Similarity, threshold float64) []Match {
+	matches := slices.MapI(candidates, func(c *string, i int) Match {
+		return Match{Index: i, Value: *c, Score: similarity(query, *c)}
+	})
+
+	matches = slices.Filter(matches, func(m Match) bool {
+		return m.Score >= threshold
+	})
+
+	slices.SortStableBy(matches, slices.By(func(m Match) float64 {
+		return m.Score
+	}).Reverse())
+
+	return matches
+}
+
+// FindClosest
+// Returns at most n candidates with a score of at least threshold, best first, see RankBySimilarity.
+func FindClosest(candidates []string, query string, similarity Similarity, n int, threshold float64) []Match {
+	matches := RankBySimilarity(candidates, query, similarity, threshold)
+	if n >= 0 && n < len(matches) {
+		matches = matches[:n]
+	}
+	return matches
+}
+
+// FindClosestIndex
+// Returns the index of the candidate most similar to query with a score of at least threshold.
+// Returns -1 if no candidate reaches the threshold.
+// Similar usage as slices.FindIndexGeneric
+func FindClosestIndex(candidates []string, query string, similarity Similarity, threshold float64) int {
+	best, bestScore := -1, threshold
+	for i := range candidates {
+		if score := similarity(query, candidates[i]); score > bestScore || (best == -1 && score >= bestScore) {
+			best, bestScore = i, score
+		}
+	}
+	return best
+}
+
+func minInt(values ...int) int {
+	ret := values[0]
+	for _, v := range values[1:] {
+		if v < ret {
+			ret = v
+		}
+	}
+	return ret
+}
+
+func maxInt(values ...int) int {
+	ret := values[0]
+	for _, v := range values[1:] {
+		if v > ret {
+			ret = v
+		}
+	}
+	return ret
+}
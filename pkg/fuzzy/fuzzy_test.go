@@ -0,0 +1,108 @@
+package fuzzy
+
+import (
+	"github.com/rbnbr/go-utility/pkg/consts"
+	"math"
+	"testing"
+)
+
+// TestLevenshtein
+// Tests the Levenshtein distance including multi-byte runes.
+func TestLevenshtein(t *testing.T) {
+	testCases := []struct {
+		a, b     string
+		expected int
+	}{
+		{"kitten", "sitting", 3},
+		{"", "abc", 3},
+		{"flaw", "lawn", 2},
+		{"héllo", "hello", 1},
+		{"ca", "ac", 2},
+	}
+
+	for _, tc := range testCases {
+		gotResult := Levenshtein(tc.a, tc.b)
+		if gotResult != tc.expected {
+			t.Errorf(consts.GotExpectedResultFmt, gotResult, tc.expected)
+		}
+	}
+}
+
+// TestDamerauLevenshtein
+// Tests that transpositions count as a single operation.
+func TestDamerauLevenshtein(t *testing.T) {
+	testCases := []struct {
+		a, b     string
+		expected int
+	}{
+		{"ca", "ac", 1},
+		{"ca", "abc", 2},
+		{"kitten", "sitting", 3},
+		{"", "", 0},
+		{"äö", "öä", 1},
+	}
+
+	for _, tc := range testCases {
+		gotResult := DamerauLevenshtein(tc.a, tc.b)
+		if gotResult != tc.expected {
+			t.Errorf(consts.GotExpectedResultFmt, gotResult, tc.expected)
+		}
+	}
+}
+
+// TestJaroWinkler
+// Tests the Jaro-Winkler similarity against well known values.
+func TestJaroWinkler(t *testing.T) {
+	testCases := []struct {
+		a, b     string
+		expected float64
+	}{
+		{"MARTHA", "MARHTA", 0.9611},
+		{"DIXON", "DICKSONX", 0.8133},
+		{"abc", "abc", 1},
+		{"abc", "xyz", 0},
+	}
+
+	for _, tc := range testCases {
+		gotResult := JaroWinkler(tc.a, tc.b)
+		if math.Abs(gotResult-tc.expected) > 1e-4 {
+			t.Errorf(consts.GotExpectedResultFmt, gotResult, tc.expected)
+		}
+	}
+}
+
+// TestFoldCase
+// Tests case insensitive comparison.
+func TestFoldCase(t *testing.T) {
+	gotResult := FoldCase(Levenshtein)("ÄrGER", "ärger")
+	if gotResult != 0 {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, 0)
+	}
+}
+
+// TestFindClosest
+// Tests the ranking, limiting and threshold of the lookup helpers.
+func TestFindClosest(t *testing.T) {
+	testCandidates := []string{"apple", "apply", "ample", "maple", "banana"}
+	similarity := NormalizedSimilarity(Levenshtein)
+
+	gotResult := FindClosest(testCandidates, "appel", similarity, 2, 0.5)
+	if len(gotResult) != 2 || gotResult[0].Value != "apple" || gotResult[1].Value != "apply" {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, "[apple apply]")
+	}
+
+	gotRanked := RankBySimilarity(testCandidates, "appel", similarity, 0.3)
+	if len(gotRanked) != 4 || gotRanked[2].Value != "ample" || gotRanked[3].Value != "maple" {
+		t.Errorf(consts.GotExpectedResultFmt, gotRanked, "[apple apply ample maple]")
+	}
+
+	gotIndex := FindClosestIndex(testCandidates, "BANAN", FoldCase(similarity), 0.5)
+	if gotIndex != 4 {
+		t.Errorf(consts.GotExpectedResultFmt, gotIndex, 4)
+	}
+
+	gotIndex = FindClosestIndex(testCandidates, "zzz", similarity, 0.5)
+	if gotIndex != -1 {
+		t.Errorf(consts.GotExpectedResultFmt, gotIndex, -1)
+	}
+}
@@ -1,7 +1,16 @@
 package maps
 
+import "github.com/rbnbr/go-utility/pkg/consts"
+
+// Same as the errors of the slices package, errors.Is works across both packages.
+var (
+	ErrNil             = error(nil)                // nil error
+	ErrInvalidArgument = consts.ErrInvalidArgument // there has been a problem with the provided argument
+	ErrDuplicateKey    = consts.ErrDuplicateKey    // a key occurred more than once where it has to be unique
+)
+
 // GetKeysOfMap
-// Returns the keys contained in someMap
+// Returns the keys contained in someMap in random order, see SortedKeys for a deterministic order.
 func GetKeysOfMap[K comparable, V any](someMap map[K]V) []K {
 	keys := make([]K, len(someMap))
 
@@ -15,7 +24,7 @@ func GetKeysOfMap[K comparable, V any](someMap map[K]V) []K {
 }
 
 // GetValuesOfMap
-// Returns a slice containing all values of the map someMap
+// Returns a slice containing all values of the map someMap in random order, see ValuesSortedByKey for a deterministic order.
 func GetValuesOfMap[K comparable, V any](someMap map[K]V) []V {
 	values := make([]V, len(someMap))
 
@@ -0,0 +1,277 @@
+package maps
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Entry
+// A key value pair of a map.
+type Entry[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+// orderedMapNode
+// An element of the doubly linked list keeping the order of an OrderedMap.
+type orderedMapNode[K comparable, V any] struct {
+	entry      Entry[K, V]
+	prev, next *orderedMapNode[K, V]
+}
+
+// OrderedMap
+// A map which remembers the order in which its keys have been inserted.
+// Get, Set, Delete, Has, MoveToFront and MoveToBack run in constant time.
+// Keys, Values, Entries, ForEach and the JSON encoding follow the insertion order.
+// Setting an existing key updates its value but keeps its position.
+// The zero value is an empty OrderedMap ready to use. Once initialised, copies of an OrderedMap share their content
+// like copies of a builtin map do. It is not safe for concurrent use.
+type OrderedMap[K comparable, V any] struct {
+	nodes map[K]*orderedMapNode[K, V]
+	// sentinel of the circular list, sentinel.next is the first and sentinel.prev the last node
+	sentinel *orderedMapNode[K, V]
+}
+
+// NewOrderedMap
+// Returns a new empty OrderedMap.
+func NewOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+	m := &OrderedMap[K, V]{}
+	m.lazyInit()
+	return m
+}
+
+// lazyInit
+// Initialises the zero value of OrderedMap on first use.
+func (m *OrderedMap[K, V]) lazyInit() {
+	if m.sentinel == nil {
+		m.nodes = make(map[K]*orderedMapNode[K, V])
+		m.sentinel = &orderedMapNode[K, V]{}
+		m.sentinel.prev = m.sentinel
+		m.sentinel.next = m.sentinel
+	}
+}
+
+// unlink
+// Removes node from the list.
+func (m *OrderedMap[K, V]) unlink(node *orderedMapNode[K, V]) {
+	node.prev.next = node.next
+	node.next.prev = node.prev
+}
+
+// linkAfter
+// Inserts node into the list right after at.
+func (m *OrderedMap[K, V]) linkAfter(node *orderedMapNode[K, V], at *orderedMapNode[K, V]) {
+	node.prev = at
+	node.next = at.next
+	at.next.prev = node
+	at.next = node
+}
+
+// Len
+// Returns the amount of keys in the map.
+func (m *OrderedMap[K, V]) Len() int {
+	return len(m.nodes)
+}
+
+// Get
+// Returns the value for key and true, or the zero value and false if key is not contained in the map.
+func (m *OrderedMap[K, V]) Get(key K) (V, bool) {
+	if node, ok := m.nodes[key]; ok {
+		return node.entry.Value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Has
+// Returns true if key is contained in the map.
+func (m *OrderedMap[K, V]) Has(key K) bool {
+	_, ok := m.nodes[key]
+	return ok
+}
+
+// Set
+// Sets the value for key. New keys are appended at the back, existing keys keep their position.
+func (m *OrderedMap[K, V]) Set(key K, value V) {
+	m.lazyInit()
+	if node, ok := m.nodes[key]; ok {
+		node.entry.Value = value
+		return
+	}
+	node := &orderedMapNode[K, V]{entry: Entry[K, V]{Key: key, Value: value}}
+	m.linkAfter(node, m.sentinel.prev)
+	m.nodes[key] = node
+}
+
+// Delete
+// Removes key from the map. Returns false if key was not contained in the map.
+func (m *OrderedMap[K, V]) Delete(key K) bool {
+	m.lazyInit()
+	node, ok := m.nodes[key]
+	if !ok {
+		return false
+	}
+	m.unlink(node)
+	delete(m.nodes, key)
+	return true
+}
+
+// MoveToFront
+// Moves key to the front of the order. Returns false if key is not contained in the map.
+func (m *OrderedMap[K, V]) MoveToFront(key K) bool {
+	m.lazyInit()
+	node, ok := m.nodes[key]
+	if !ok {
+		return false
+	}
+	m.unlink(node)
+	m.linkAfter(node, m.sentinel)
+	return true
+}
+
+// MoveToBack
+// Moves key to the back of the order. Returns false if key is not contained in the map.
+func (m *OrderedMap[K, V]) MoveToBack(key K) bool {
+	m.lazyInit()
+	node, ok := m.nodes[key]
+	if !ok {
+		return false
+	}
+	m.unlink(node)
+	m.linkAfter(node, m.sentinel.prev)
+	return true
+}
+
+// ForEach
+// Calls fn for every key value pair in order. Stops early if fn returns false.
+// fn must not add, delete or move keys.
+func (m *OrderedMap[K, V]) ForEach(fn func(key K, value V) bool) {
+	m.lazyInit()
+	for node := m.sentinel.next; node != m.sentinel; node = node.next {
+		if !fn(node.entry.Key, node.entry.Value) {
+			return
+		}
+	}
+}
+
+// Keys
+// Returns the keys of the map in order.
+func (m *OrderedMap[K, V]) Keys() []K {
+	keys := make([]K, 0, m.Len())
+	m.ForEach(func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// Values
+// Returns the values of the map in the order of their keys.
+func (m *OrderedMap[K, V]) Values() []V {
+	values := make([]V, 0, m.Len())
+	m.ForEach(func(_ K, value V) bool {
+		values = append(values, value)
+		return true
+	})
+	return values
+}
+
+// Entries
+// Returns the key value pairs of the map in order.
+func (m *OrderedMap[K, V]) Entries() []Entry[K, V] {
+	entries := make([]Entry[K, V], 0, m.Len())
+	m.ForEach(func(key K, value V) bool {
+		entries = append(entries, Entry[K, V]{Key: key, Value: value})
+		return true
+	})
+	return entries
+}
+
+// MarshalJSON
+// Encodes the map as a JSON object with the members in order.
+// Keys are encoded the same way encoding/json encodes the keys of a builtin map,
+// i.e., K has to be a string or integer type or implement encoding.TextMarshaler.
+// Uses a value receiver so that an OrderedMap is encoded the same way whether it is marshalled by value or by pointer.
+func (m OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	if m.sentinel != nil {
+		for node := m.sentinel.next; node != m.sentinel; node = node.next {
+			// encode a single entry map to reuse the key encoding of encoding/json
+			member, err := json.Marshal(map[K]V{node.entry.Key: node.entry.Value})
+			if err != nil {
+				return nil, err
+			}
+			if node != m.sentinel.next {
+				buf.WriteByte(',')
+			}
+			buf.Write(member[1 : len(member)-1])
+		}
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON
+// Decodes a JSON object into the map, replacing its previous content and keeping the order of the members.
+// Keys are decoded the same way encoding/json decodes the keys of a builtin map.
+// If a key occurs multiple times, the last value is kept at the position of the first occurrence.
+// JSON null leaves the map unchanged. If decoding fails, the map keeps its previous content.
+func (m *OrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token == nil {
+		return nil
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("cannot decode %v into an OrderedMap, expected a JSON object", token)
+	}
+
+	decoded := NewOrderedMap[K, V]()
+	for decoder.More() {
+		token, err = decoder.Token()
+		if err != nil {
+			return err
+		}
+
+		// decode a single member object to reuse the key decoding of encoding/json
+		encodedKey, err := json.Marshal(token)
+		if err != nil {
+			return err
+		}
+		keys := make(map[K]struct{}, 1)
+		if err = json.Unmarshal([]byte("{"+string(encodedKey)+":{}}"), &keys); err != nil {
+			return err
+		}
+
+		var value V
+		if err = decoder.Decode(&value); err != nil {
+			return err
+		}
+		for key := range keys {
+			decoded.Set(key, value)
+		}
+	}
+
+	if _, err = decoder.Token(); err != nil {
+		return err
+	}
+	*m = *decoded
+	return nil
+}
+
+// GroupByToOrderedMap
+// Same as slices.GroupBy but returns an OrderedMap with the keys ordered by their first occurrence in the provided slice.
+func GroupByToOrderedMap[T comparable, V any](slice []V, accessor func(v V) T) *OrderedMap[T, []V] {
+	m := NewOrderedMap[T, []V]()
+	for i := range slice {
+		key := accessor(slice[i])
+		group, _ := m.Get(key)
+		m.Set(key, append(group, slice[i]))
+	}
+	return m
+}
@@ -0,0 +1,194 @@
+package maps
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/rbnbr/go-utility/pkg/consts"
+	"github.com/rbnbr/go-utility/pkg/slices"
+	"testing"
+)
+
+// TestOrderedMap
+// Tests that Set, Delete and the Move functions maintain the order of the keys.
+func TestOrderedMap(t *testing.T) {
+	testMap := NewOrderedMap[string, int]()
+	for i, k := range []string{"c", "a", "d", "b"} {
+		testMap.Set(k, i)
+	}
+	testMap.Set("a", 42)
+	testMap.Delete("d")
+	testMap.MoveToFront("b")
+	testMap.MoveToBack("c")
+	testMap.Set("e", 5)
+
+	expectedResult := []string{"b", "a", "c", "e"}
+	gotResult := testMap.Keys()
+	if !slices.Equal(gotResult, expectedResult, func(a, b string) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	expectedValues := []int{3, 42, 0, 5}
+	gotValues := testMap.Values()
+	if !slices.Equal(gotValues, expectedValues, func(a, b int) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotValues, expectedValues)
+	}
+
+	if v, ok := testMap.Get("a"); !ok || v != 42 || testMap.Has("d") || testMap.Len() != 4 {
+		t.Errorf(consts.GotExpectedResultFmt, v, 42)
+	}
+	if testMap.Delete("d") || testMap.MoveToFront("d") {
+		t.Errorf(consts.GotExpectedResultFmt, true, false)
+	}
+
+	gotEntries := fmt.Sprint(testMap.Entries())
+	expectedEntries := "[{b 3} {a 42} {c 0} {e 5}]"
+	if gotEntries != expectedEntries {
+		t.Errorf(consts.GotExpectedResultFmt, gotEntries, expectedEntries)
+	}
+}
+
+// TestOrderedMap_JSON
+// Tests that the JSON encoding keeps the order of the keys in both directions.
+func TestOrderedMap_JSON(t *testing.T) {
+	expectedResult := `{"z":[1],"a":[2,3],"m":null}`
+
+	var testMap OrderedMap[string, []int]
+	if err := json.Unmarshal([]byte(expectedResult), &testMap); err != nil {
+		t.Fatalf(consts.GotExpectedErrorFmt, err, nil)
+	}
+
+	gotBytes, err := json.Marshal(&testMap)
+	if err != nil || string(gotBytes) != expectedResult {
+		t.Errorf(consts.GotExpectedResultFmt, string(gotBytes), expectedResult)
+	}
+
+	intMap := NewOrderedMap[int, string]()
+	intMap.Set(10, "x")
+	intMap.Set(2, "y")
+	gotBytes, _ = json.Marshal(intMap)
+	if string(gotBytes) != `{"10":"x","2":"y"}` {
+		t.Errorf(consts.GotExpectedResultFmt, string(gotBytes), `{"10":"x","2":"y"}`)
+	}
+
+	gotIntMap := NewOrderedMap[int, string]()
+	if err := json.Unmarshal(gotBytes, gotIntMap); err != nil || gotIntMap.Keys()[0] != 10 {
+		t.Errorf(consts.GotExpectedResultFmt, gotIntMap.Keys(), []int{10, 2})
+	}
+
+	if err := json.Unmarshal([]byte(`{"a":"x"}`), gotIntMap); err == nil {
+		t.Errorf(consts.GotExpectedErrorFmt, err, "json error")
+	}
+	if err := json.Unmarshal([]byte(`["a"]`), gotIntMap); err == nil {
+		t.Errorf(consts.GotExpectedErrorFmt, err, "json error")
+	}
+}
+
+// TestGroupByToOrderedMap
+// Tests that the groups are ordered by the first occurrence of their key.
+func TestGroupByToOrderedMap(t *testing.T) {
+	testSlice := []string{"banana", "apple", "blueberry", "cherry", "avocado"}
+
+	expectedResult := "[{b [banana blueberry]} {a [apple avocado]} {c [cherry]}]"
+	gotResult := fmt.Sprint(GroupByToOrderedMap(testSlice, func(s string) string {
+		return s[:1]
+	}).Entries())
+	if gotResult != expectedResult {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+}
+
+// TestOrderedMap_zeroValue
+// Tests that the zero value of OrderedMap can be used without NewOrderedMap and is encoded by value, also as a struct field.
+func TestOrderedMap_zeroValue(t *testing.T) {
+	var testMap OrderedMap[string, int]
+	if testMap.Len() != 0 || testMap.Has("a") || len(testMap.Keys()) != 0 || testMap.Delete("a") || testMap.MoveToBack("a") {
+		t.Errorf(consts.GotExpectedResultFmt, testMap.Keys(), []string{})
+	}
+
+	testMap.Set("b", 2)
+	testMap.Set("a", 1)
+	gotResult := fmt.Sprint(testMap.Entries())
+	expectedResult := "[{b 2} {a 1}]"
+	if gotResult != expectedResult {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	type document struct {
+		Fields OrderedMap[string, int] `json:"fields"`
+	}
+	var emptyDocument document
+	gotBytes, err := json.Marshal(emptyDocument)
+	if err != nil || string(gotBytes) != `{"fields":{}}` {
+		t.Errorf(consts.GotExpectedResultFmt, string(gotBytes), `{"fields":{}}`)
+	}
+
+	var testDocument document
+	testDocument.Fields.Set("x", 1)
+	testDocument.Fields.Set("a", 2)
+	expectedJSON := `{"fields":{"x":1,"a":2}}`
+	for _, value := range []any{testDocument, &testDocument} {
+		gotBytes, err = json.Marshal(value)
+		if err != nil || string(gotBytes) != expectedJSON {
+			t.Errorf(consts.GotExpectedResultFmt, string(gotBytes), expectedJSON)
+		}
+	}
+
+	gotBytes, err = json.Marshal(testMap)
+	if err != nil || string(gotBytes) != `{"b":2,"a":1}` {
+		t.Errorf(consts.GotExpectedResultFmt, string(gotBytes), `{"b":2,"a":1}`)
+	}
+}
+
+// TestOrderedMap_copy
+// Tests that copies of an OrderedMap share their content instead of corrupting the order.
+func TestOrderedMap_copy(t *testing.T) {
+	type document struct {
+		Fields OrderedMap[string, int] `json:"fields"`
+	}
+
+	var original document
+	if err := json.Unmarshal([]byte(`{"fields":{"x":1,"y":2}}`), &original); err != nil {
+		t.Fatalf(consts.GotExpectedErrorFmt, err, nil)
+	}
+
+	copied := original
+	calls := 0
+	copied.Fields.ForEach(func(_ string, _ int) bool {
+		calls++
+		return calls < 100
+	})
+	if calls != 2 {
+		t.Errorf(consts.GotExpectedResultFmt, calls, 2)
+	}
+
+	copied.Fields.Set("z", 3)
+	if !original.Fields.Has("z") {
+		t.Errorf(consts.GotExpectedResultFmt, original.Fields.Keys(), []string{"x", "y", "z"})
+	}
+}
+
+// TestOrderedMap_unmarshalNullAndError
+// Tests that JSON null is a no-op and a failed decode keeps the previous content.
+func TestOrderedMap_unmarshalNullAndError(t *testing.T) {
+	testMap := NewOrderedMap[string, int]()
+	testMap.Set("a", 1)
+
+	if err := json.Unmarshal([]byte(`null`), testMap); err != nil || testMap.Len() != 1 {
+		t.Errorf(consts.GotExpectedErrorFmt, err, nil)
+	}
+
+	if err := json.Unmarshal([]byte(`{"b":2,"c":"x"}`), testMap); err == nil {
+		t.Errorf(consts.GotExpectedErrorFmt, err, "json error")
+	}
+	expectedResult := []string{"a"}
+	gotResult := testMap.Keys()
+	if !slices.Equal(gotResult, expectedResult, func(a, b string) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+}
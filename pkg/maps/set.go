@@ -0,0 +1,196 @@
+package maps
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+)
+
+// Set
+// A set of comparable elements backed by a map, thus it can be ranged over, compared to nil and created via make like a map.
+// The zero value is a nil set which can be read from but not added to, use NewSet or make instead.
+// Methods returning a Set always return a new set and leave the receiver and their arguments unchanged.
+// Has can be passed as predicate directly, e.g., slices.Filter(someSlice, someSet.Has).
+type Set[T comparable] map[T]struct{}
+
+// NewSet
+// Returns a new set containing the provided values.
+// Example:
+// NewSet(1, 2, 2) -> {1, 2}
+// NewSet(someSlice...)
+func NewSet[T comparable](values ...T) Set[T] {
+	s := make(Set[T], len(values))
+	s.Add(values...)
+	return s
+}
+
+// NewSetBy
+// Returns a new set containing the identifiers of the elements of slice got via the accessor function.
+func NewSetBy[S any, T comparable](slice []S, accessor func(S) T) Set[T] {
+	s := make(Set[T], len(slice))
+	for i := range slice {
+		s[accessor(slice[i])] = struct{}{}
+	}
+	return s
+}
+
+// Add
+// Adds the provided values to the set.
+func (s Set[T]) Add(values ...T) {
+	for _, v := range values {
+		s[v] = struct{}{}
+	}
+}
+
+// Remove
+// Removes the provided values from the set. Values not contained in the set are ignored.
+func (s Set[T]) Remove(values ...T) {
+	for _, v := range values {
+		delete(s, v)
+	}
+}
+
+// Has
+// Returns true if value is contained in the set.
+func (s Set[T]) Has(value T) bool {
+	_, ok := s[value]
+	return ok
+}
+
+// Len
+// Returns the amount of elements in the set.
+func (s Set[T]) Len() int {
+	return len(s)
+}
+
+// Clone
+// Returns a new set containing the same elements.
+func (s Set[T]) Clone() Set[T] {
+	ret := make(Set[T], len(s))
+	for v := range s {
+		ret[v] = struct{}{}
+	}
+	return ret
+}
+
+// Union
+// Returns a new set containing all elements which are contained in s or other.
+func (s Set[T]) Union(other Set[T]) Set[T] {
+	ret := s.Clone()
+	for v := range other {
+		ret[v] = struct{}{}
+	}
+	return ret
+}
+
+// Intersection
+// Returns a new set containing all elements which are contained in both s and other.
+func (s Set[T]) Intersection(other Set[T]) Set[T] {
+	small, large := s, other
+	if len(small) > len(large) {
+		small, large = large, small
+	}
+
+	ret := make(Set[T])
+	for v := range small {
+		if large.Has(v) {
+			ret[v] = struct{}{}
+		}
+	}
+	return ret
+}
+
+// Difference
+// Returns a new set containing all elements of s which are not contained in other.
+func (s Set[T]) Difference(other Set[T]) Set[T] {
+	ret := make(Set[T])
+	for v := range s {
+		if !other.Has(v) {
+			ret[v] = struct{}{}
+		}
+	}
+	return ret
+}
+
+// IsSubset
+// Returns true if all elements of s are contained in other.
+func (s Set[T]) IsSubset(other Set[T]) bool {
+	if len(s) > len(other) {
+		return false
+	}
+	for v := range s {
+		if !other.Has(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset
+// Returns true if all elements of other are contained in s.
+func (s Set[T]) IsSuperset(other Set[T]) bool {
+	return other.IsSubset(s)
+}
+
+// Equal
+// Returns true if s and other contain the same elements.
+func (s Set[T]) Equal(other Set[T]) bool {
+	return len(s) == len(other) && s.IsSubset(other)
+}
+
+// ToSlice
+// Returns a slice containing all elements of the set in unspecified order.
+func (s Set[T]) ToSlice() []T {
+	return GetKeysOfMap(s)
+}
+
+// ToSortedSlice
+// Returns a slice containing all elements of the set sorted by the provided comparator,
+// which returns a negative value if a < b, 0 if a == b and a positive value if a > b.
+// A slices.Comparator can be passed directly, e.g., s.ToSortedSlice(slices.CompareNatural[int]).
+func (s Set[T]) ToSortedSlice(comparator func(a, b T) int) []T {
+	ret := s.ToSlice()
+	sort.Slice(ret, func(i, j int) bool {
+		return comparator(ret[i], ret[j]) < 0
+	})
+	return ret
+}
+
+// MarshalJSON
+// Encodes the set as a JSON array.
+// The elements are ordered by their JSON encoding so that equal sets always result in the same output.
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	encoded := make([][]byte, 0, len(s))
+	for v := range s {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		encoded = append(encoded, b)
+	}
+	sort.Slice(encoded, func(i, j int) bool {
+		return bytes.Compare(encoded[i], encoded[j]) < 0
+	})
+
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	for i, b := range encoded {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.Write(b)
+	}
+	buf.WriteByte(']')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON
+// Decodes a JSON array into the set, replacing its previous content. Duplicate elements are merged.
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	*s = NewSet(values...)
+	return nil
+}
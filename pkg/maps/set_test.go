@@ -0,0 +1,93 @@
+package maps
+
+import (
+	"encoding/json"
+	"github.com/rbnbr/go-utility/pkg/consts"
+	"github.com/rbnbr/go-utility/pkg/slices"
+	"testing"
+)
+
+// TestSet
+// Tests the basic operations of Set.
+func TestSet(t *testing.T) {
+	testSet := NewSet(1, 2, 2, 3)
+
+	if testSet.Len() != 3 || !testSet.Has(2) || testSet.Has(4) {
+		t.Errorf(consts.GotExpectedResultFmt, testSet, NewSet(1, 2, 3))
+	}
+
+	testSet.Add(4, 5)
+	testSet.Remove(1, 42)
+	expectedResult := []int{2, 3, 4, 5}
+	gotResult := testSet.ToSortedSlice(slices.CompareNatural[int])
+	if !slices.Equal(gotResult, expectedResult, func(a, b int) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	gotFiltered := slices.Filter([]int{1, 2, 3, 6}, testSet.Has)
+	if len(gotFiltered) != 2 {
+		t.Errorf(consts.GotExpectedResultFmt, gotFiltered, []int{2, 3})
+	}
+
+	gotClone := testSet.Clone()
+	gotClone.Add(100)
+	if testSet.Has(100) {
+		t.Errorf(consts.GotExpectedResultFmt, testSet, "unchanged set")
+	}
+}
+
+// TestSet_operations
+// Tests Union, Intersection, Difference and the subset relations.
+func TestSet_operations(t *testing.T) {
+	a := NewSet("a", "b", "c")
+	b := NewSet("b", "c", "d")
+
+	if gotResult := a.Union(b); !gotResult.Equal(NewSet("a", "b", "c", "d")) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, NewSet("a", "b", "c", "d"))
+	}
+	if gotResult := a.Intersection(b); !gotResult.Equal(NewSet("b", "c")) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, NewSet("b", "c"))
+	}
+	if gotResult := a.Difference(b); !gotResult.Equal(NewSet("a")) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, NewSet("a"))
+	}
+	if a.Len() != 3 || b.Len() != 3 {
+		t.Errorf(consts.GotExpectedResultFmt, a, "unchanged set")
+	}
+
+	if !NewSet("b").IsSubset(a) || a.IsSubset(b) || !a.IsSuperset(NewSet[string]()) {
+		t.Errorf(consts.GotExpectedResultFmt, a.IsSubset(b), false)
+	}
+
+	gotSet := NewSetBy([]string{"a", "bb", "cc"}, func(s string) int {
+		return len(s)
+	})
+	if !gotSet.Equal(NewSet(1, 2)) {
+		t.Errorf(consts.GotExpectedResultFmt, gotSet, NewSet(1, 2))
+	}
+}
+
+// TestSet_JSON
+// Tests that a Set is encoded as a sorted JSON array and decoded back.
+func TestSet_JSON(t *testing.T) {
+	testSet := NewSet("c", "a", "b")
+
+	expectedResult := `{"tags":["a","b","c"]}`
+	gotBytes, err := json.Marshal(struct {
+		Tags Set[string] `json:"tags"`
+	}{testSet})
+	if err != nil || string(gotBytes) != expectedResult {
+		t.Errorf(consts.GotExpectedResultFmt, string(gotBytes), expectedResult)
+	}
+
+	var gotSet Set[string]
+	if err := json.Unmarshal([]byte(`["x","y","x"]`), &gotSet); err != nil || !gotSet.Equal(NewSet("x", "y")) {
+		t.Errorf(consts.GotExpectedResultFmt, gotSet, NewSet("x", "y"))
+	}
+
+	if err := json.Unmarshal([]byte(`{"x":1}`), &gotSet); err == nil {
+		t.Errorf(consts.GotExpectedErrorFmt, err, "json error")
+	}
+}
@@ -0,0 +1,93 @@
+package maps
+
+import "sort"
+
+// Ordered
+// Constraint for all types that support the operators < <= >= >.
+// Same as slices.Ordered, which cannot be used here since the slices tests import this package.
+type Ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 |
+		~string
+}
+
+// compareOrdered
+// Implements the natural ordering of ordered types the same way slices.CompareNatural does,
+// i.e., NaN values are considered equal to each other and less than any other value.
+func compareOrdered[T Ordered](a, b T) int {
+	aNaN, bNaN := a != a, b != b
+	switch {
+	case aNaN && bNaN:
+		return 0
+	case aNaN || a < b:
+		return -1
+	case bNaN || a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// KeysSortedBy
+// Returns the keys of someMap sorted by the provided comparator,
+// which returns a negative value if a < b, 0 if a == b and a positive value if a > b.
+// A slices.Comparator can be passed directly.
+func KeysSortedBy[K comparable, V any](someMap map[K]V, comparator func(a, b K) int) []K {
+	keys := GetKeysOfMap(someMap)
+	sort.Slice(keys, func(i, j int) bool {
+		return comparator(keys[i], keys[j]) < 0
+	})
+	return keys
+}
+
+// SortedKeys
+// Returns the keys of someMap in ascending order.
+// Unlike GetKeysOfMap, the result is deterministic.
+func SortedKeys[K Ordered, V any](someMap map[K]V) []K {
+	return KeysSortedBy(someMap, compareOrdered[K])
+}
+
+// ValuesSortedByKey
+// Returns the values of someMap in the ascending order of their keys.
+// Unlike GetValuesOfMap, the result is deterministic.
+func ValuesSortedByKey[K Ordered, V any](someMap map[K]V) []V {
+	keys := SortedKeys(someMap)
+	values := make([]V, len(keys))
+	for i, k := range keys {
+		values[i] = someMap[k]
+	}
+	return values
+}
+
+// ForEachSorted
+// Calls fn for every key value pair of someMap in the ascending order of the keys.
+// Stops early if fn returns false.
+// Example:
+// ForEachSorted(counts, func(k string, v int) bool { fmt.Println(k, v); return true })
+func ForEachSorted[K Ordered, V any](someMap map[K]V, fn func(key K, value V) bool) {
+	for _, k := range SortedKeys(someMap) {
+		if !fn(k, someMap[k]) {
+			return
+		}
+	}
+}
+
+// EntriesSortedByValue
+// Returns the key value pairs of someMap sorted by their values using the provided comparator.
+// Entries with equal values are ordered by the ascending order of their keys, thus the result is deterministic.
+// Example:
+// top := EntriesSortedByValue(counts, func(a, b int) int { return b - a })[:3] // the three highest counts
+func EntriesSortedByValue[K Ordered, V any](someMap map[K]V, comparator func(a, b V) int) []Entry[K, V] {
+	entries := make([]Entry[K, V], 0, len(someMap))
+	for k, v := range someMap {
+		entries = append(entries, Entry[K, V]{Key: k, Value: v})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if c := comparator(entries[i].Value, entries[j].Value); c != 0 {
+			return c < 0
+		}
+		return compareOrdered(entries[i].Key, entries[j].Key) < 0
+	})
+	return entries
+}
@@ -0,0 +1,76 @@
+package maps
+
+import (
+	"fmt"
+	"github.com/rbnbr/go-utility/pkg/consts"
+	"github.com/rbnbr/go-utility/pkg/slices"
+	"math"
+	"strings"
+	"testing"
+)
+
+// TestSortedKeys
+// Tests SortedKeys, KeysSortedBy and ValuesSortedByKey.
+func TestSortedKeys(t *testing.T) {
+	testMap := map[string]int{"b": 2, "c": -3, "a": 0}
+
+	expectedResult := []string{"a", "b", "c"}
+	gotResult := SortedKeys(testMap)
+	if !slices.Equal(gotResult, expectedResult, func(a, b string) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	expectedResult = []string{"c", "b", "a"}
+	gotResult = KeysSortedBy(testMap, slices.Natural[string]().Reverse())
+	if !slices.Equal(gotResult, expectedResult, func(a, b string) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	expectedValues := []int{0, 2, -3}
+	gotValues := ValuesSortedByKey(testMap)
+	if !slices.Equal(gotValues, expectedValues, func(a, b int) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotValues, expectedValues)
+	}
+
+	floatKeys := SortedKeys(map[float64]bool{1: true, math.NaN(): true, -1: true})
+	if !math.IsNaN(floatKeys[0]) || floatKeys[1] != -1 || floatKeys[2] != 1 {
+		t.Errorf(consts.GotExpectedResultFmt, floatKeys, []float64{math.NaN(), -1, 1})
+	}
+}
+
+// TestForEachSorted
+// Tests that ForEachSorted visits the keys in order and stops early.
+func TestForEachSorted(t *testing.T) {
+	testMap := map[int]string{3: "c", 1: "a", 2: "b", 4: "d"}
+
+	var builder strings.Builder
+	ForEachSorted(testMap, func(k int, v string) bool {
+		builder.WriteString(v)
+		return k < 3
+	})
+
+	expectedResult := "abc"
+	if builder.String() != expectedResult {
+		t.Errorf(consts.GotExpectedResultFmt, builder.String(), expectedResult)
+	}
+}
+
+// TestEntriesSortedByValue
+// Tests that entries are sorted by value with ties broken by key.
+func TestEntriesSortedByValue(t *testing.T) {
+	testMap := map[string]int{"x": 1, "b": 5, "a": 5, "y": 3}
+
+	expectedResult := "[{a 5} {b 5} {y 3} {x 1}]"
+	gotResult := fmt.Sprint(EntriesSortedByValue(testMap, func(a, b int) int {
+		return b - a
+	}))
+	if gotResult != expectedResult {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+}
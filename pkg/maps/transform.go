@@ -0,0 +1,170 @@
+package maps
+
+import "fmt"
+
+// CollisionPolicy
+// Decides what happens if multiple keys are mapped to the same new key, see MapKeysConfigurable.
+type CollisionPolicy int
+
+const (
+	CollisionError     CollisionPolicy = iota // return ErrDuplicateKey
+	CollisionKeepFirst                        // keep the value of the first colliding key
+	CollisionKeepLast                         // keep the value of the last colliding key
+	CollisionMerge                            // combine the values of the colliding keys using a merge function
+)
+
+// MapValues
+// Returns a new map with the same keys where every value is replaced by the result of the mapping function.
+// The pointer passed to mapping points to a copy of the value, thus modifying it does not change someMap.
+func MapValues[K comparable, V any, W any](someMap map[K]V, mapping func(v *V) W) map[K]W {
+	ret := make(map[K]W, len(someMap))
+	for k, v := range someMap {
+		ret[k] = mapping(&v)
+	}
+	return ret
+}
+
+// MapValuesK
+// Same as MapValues but also passes the key of the current value to the mapping function.
+func MapValuesK[K comparable, V any, W any](someMap map[K]V, mapping func(v *V, k K) W) map[K]W {
+	ret := make(map[K]W, len(someMap))
+	for k, v := range someMap {
+		ret[k] = mapping(&v, k)
+	}
+	return ret
+}
+
+// MapKeysConfigurable
+// Returns a new map where every key is replaced by the result of the mapping function, keeping its value.
+// The policy decides what happens if multiple keys are mapped to the same new key:
+// CollisionError returns ErrDuplicateKey, CollisionKeepFirst and CollisionKeepLast keep the value of the first or last
+// colliding key, and CollisionMerge combines the values the same way slices.Reduce does,
+// i.e., merge receives the value of the next colliding key and the current aggregate.
+// The keys are visited in the order of the comparator, which returns a negative value if a < b, 0 if a == b and a
+// positive value if a > b. If the comparator is nil, the keys are visited in the random order of the map,
+// thus which value is kept, or in which order values are merged, is unspecified.
+// Returns ErrInvalidArgument if the policy is unknown or CollisionMerge is used without a merge function.
+func MapKeysConfigurable[K comparable, V any, L comparable](someMap map[K]V, mapping func(k *K) L, policy CollisionPolicy,
+	merge func(newValue *V, aggregate *V) V, comparator func(a, b K) int) (map[L]V, error) {
+	if policy < CollisionError || policy > CollisionMerge {
+		return nil, fmt.Errorf("%w: unknown collision policy %d", ErrInvalidArgument, policy)
+	}
+	if policy == CollisionMerge && merge == nil {
+		return nil, fmt.Errorf("%w: collision policy CollisionMerge requires a merge function", ErrInvalidArgument)
+	}
+
+	var keys []K
+	if comparator == nil {
+		keys = GetKeysOfMap(someMap)
+	} else {
+		keys = KeysSortedBy(someMap, comparator)
+	}
+
+	ret := make(map[L]V, len(someMap))
+	for i := range keys {
+		l := mapping(&keys[i])
+		v := someMap[keys[i]]
+
+		existing, ok := ret[l]
+		if !ok {
+			ret[l] = v
+			continue
+		}
+
+		switch policy {
+		case CollisionError:
+			return nil, fmt.Errorf("%w: '%v' for key '%v'", ErrDuplicateKey, l, keys[i])
+		case CollisionKeepLast:
+			ret[l] = v
+		case CollisionMerge:
+			ret[l] = merge(&v, &existing)
+		}
+	}
+
+	return ret, nil
+}
+
+// MapKeys
+// Same as MapKeysConfigurable but returns ErrDuplicateKey if multiple keys are mapped to the same new key.
+// Shorthand for MapKeysConfigurable(someMap, mapping, CollisionError, nil, nil)
+func MapKeys[K comparable, V any, L comparable](someMap map[K]V, mapping func(k *K) L) (map[L]V, error) {
+	return MapKeysConfigurable(someMap, mapping, CollisionError, nil, nil)
+}
+
+// MapKeysKeepFirst
+// Same as MapKeysConfigurable but keeps the value of the first colliding key in the order of the comparator.
+// Shorthand for MapKeysConfigurable(someMap, mapping, CollisionKeepFirst, nil, comparator)
+func MapKeysKeepFirst[K comparable, V any, L comparable](someMap map[K]V, mapping func(k *K) L, comparator func(a, b K) int) map[L]V {
+	ret, _ := MapKeysConfigurable(someMap, mapping, CollisionKeepFirst, nil, comparator)
+	return ret
+}
+
+// MapKeysKeepLast
+// Same as MapKeysConfigurable but keeps the value of the last colliding key in the order of the comparator.
+// Shorthand for MapKeysConfigurable(someMap, mapping, CollisionKeepLast, nil, comparator)
+func MapKeysKeepLast[K comparable, V any, L comparable](someMap map[K]V, mapping func(k *K) L, comparator func(a, b K) int) map[L]V {
+	ret, _ := MapKeysConfigurable(someMap, mapping, CollisionKeepLast, nil, comparator)
+	return ret
+}
+
+// MapKeysMerge
+// Same as MapKeysConfigurable but combines the values of colliding keys using the merge function.
+// The keys are visited in random order, thus merge should be commutative.
+// Shorthand for MapKeysConfigurable(someMap, mapping, CollisionMerge, merge, nil)
+// Example:
+// byLowerCase, _ := MapKeysMerge(counts, func(k *string) string { return strings.ToLower(*k) }, func(n *int, sum *int) int { return *n + *sum })
+func MapKeysMerge[K comparable, V any, L comparable](someMap map[K]V, mapping func(k *K) L, merge func(newValue *V, aggregate *V) V) (map[L]V, error) {
+	return MapKeysConfigurable(someMap, mapping, CollisionMerge, merge, nil)
+}
+
+// FilterMap
+// Returns a new map which only contains those key value pairs of someMap for which the predicate evaluates to true.
+func FilterMap[K comparable, V any](someMap map[K]V, predicate func(K, V) bool) map[K]V {
+	ret := make(map[K]V)
+	for k, v := range someMap {
+		if predicate(k, v) {
+			ret[k] = v
+		}
+	}
+	return ret
+}
+
+// PartitionMap
+// Splits someMap into two new maps, the first containing the key value pairs for which the predicate evaluates to true,
+// the second containing the rest.
+func PartitionMap[K comparable, V any](someMap map[K]V, predicate func(K, V) bool) (map[K]V, map[K]V) {
+	matching, rest := make(map[K]V), make(map[K]V)
+	for k, v := range someMap {
+		if predicate(k, v) {
+			matching[k] = v
+		} else {
+			rest[k] = v
+		}
+	}
+	return matching, rest
+}
+
+// Invert
+// Returns a new map mapping the values of someMap to their keys.
+// Returns ErrDuplicateKey if multiple keys share the same value, see InvertMulti for maps which are not injective.
+func Invert[K comparable, V comparable](someMap map[K]V) (map[V]K, error) {
+	ret := make(map[V]K, len(someMap))
+	for k, v := range someMap {
+		if _, ok := ret[v]; ok {
+			return nil, fmt.Errorf("%w: value '%v' occurs more than once", ErrDuplicateKey, v)
+		}
+		ret[v] = k
+	}
+	return ret, nil
+}
+
+// InvertMulti
+// Returns a new map mapping the values of someMap to all keys sharing this value.
+// The order of the keys in each slice is random.
+func InvertMulti[K comparable, V comparable](someMap map[K]V) map[V][]K {
+	ret := make(map[V][]K)
+	for k, v := range someMap {
+		ret[v] = append(ret[v], k)
+	}
+	return ret
+}
@@ -0,0 +1,121 @@
+package maps
+
+import (
+	"errors"
+	"fmt"
+	"github.com/rbnbr/go-utility/pkg/consts"
+	"github.com/rbnbr/go-utility/pkg/slices"
+	"strings"
+	"testing"
+)
+
+// TestMapValues
+// Tests MapValues and MapValuesK.
+func TestMapValues(t *testing.T) {
+	testMap := map[string]int{"a": 1, "b": 2}
+
+	expectedResult := "map[a:2 b:4]"
+	gotResult := fmt.Sprint(MapValues(testMap, func(v *int) int {
+		return *v * 2
+	}))
+	if gotResult != expectedResult {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	expectedResult = "map[a:a1 b:b2]"
+	gotResult = fmt.Sprint(MapValuesK(testMap, func(v *int, k string) string {
+		return fmt.Sprint(k, *v)
+	}))
+	if gotResult != expectedResult {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+}
+
+// TestMapKeys
+// Tests MapKeys with all collision policies.
+func TestMapKeys(t *testing.T) {
+	testMap := map[string]int{"a": 1, "A": 2, "b": 3}
+	lower := func(k *string) string {
+		return strings.ToLower(*k)
+	}
+
+	gotResult, gotError := MapKeys(map[string]int{"a": 1, "B": 3}, lower)
+	if !errors.Is(gotError, ErrNil) || fmt.Sprint(gotResult) != "map[a:1 b:3]" {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, "map[a:1 b:3]")
+	}
+
+	_, gotError = MapKeys(testMap, lower)
+	if !errors.Is(gotError, ErrDuplicateKey) || !errors.Is(gotError, slices.ErrDuplicateKey) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, slices.ErrDuplicateKey)
+	}
+
+	// "A" < "a" in the natural order
+	expectedResult := "map[a:2 b:3]"
+	gotResult = MapKeysKeepFirst(testMap, lower, slices.CompareNatural[string])
+	if fmt.Sprint(gotResult) != expectedResult {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	expectedResult = "map[a:1 b:3]"
+	gotResult = MapKeysKeepLast(testMap, lower, slices.CompareNatural[string])
+	if fmt.Sprint(gotResult) != expectedResult {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	expectedResult = "map[a:3 b:3]"
+	gotResult, _ = MapKeysMerge(testMap, lower, func(v *int, sum *int) int {
+		return *v + *sum
+	})
+	if fmt.Sprint(gotResult) != expectedResult {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	_, gotError = MapKeysConfigurable(testMap, lower, CollisionMerge, nil, nil)
+	if !errors.Is(gotError, ErrInvalidArgument) || !errors.Is(gotError, slices.ErrInvalidArgument) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, slices.ErrInvalidArgument)
+	}
+}
+
+// TestFilterPartitionMap
+// Tests FilterMap and PartitionMap.
+func TestFilterPartitionMap(t *testing.T) {
+	testMap := map[string]int{"a": 1, "b": 2, "c": 3, "d": 4}
+	predicate := func(k string, v int) bool {
+		return k != "a" && v%2 == 1
+	}
+
+	expectedResult := "map[c:3]"
+	gotResult := fmt.Sprint(FilterMap(testMap, predicate))
+	if gotResult != expectedResult {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	gotMatching, gotRest := PartitionMap(testMap, predicate)
+	if fmt.Sprint(gotMatching) != expectedResult || fmt.Sprint(gotRest) != "map[a:1 b:2 d:4]" {
+		t.Errorf(consts.GotExpectedResultFmt, gotRest, "map[a:1 b:2 d:4]")
+	}
+}
+
+// TestInvert
+// Tests Invert for injective and non-injective maps and InvertMulti.
+func TestInvert(t *testing.T) {
+	gotResult, gotError := Invert(map[string]int{"a": 1, "b": 2})
+	if !errors.Is(gotError, ErrNil) || fmt.Sprint(gotResult) != "map[1:a 2:b]" {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, "map[1:a 2:b]")
+	}
+
+	testMap := map[string]int{"a": 1, "b": 2, "c": 1}
+	_, gotError = Invert(testMap)
+	if !errors.Is(gotError, ErrDuplicateKey) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, ErrDuplicateKey)
+	}
+
+	gotMulti := InvertMulti(testMap)
+	for _, keys := range gotMulti {
+		slices.SortBy(keys, slices.CompareNatural[string])
+	}
+	expectedResult := "map[1:[a c] 2:[b]]"
+	if fmt.Sprint(gotMulti) != expectedResult {
+		t.Errorf(consts.GotExpectedResultFmt, gotMulti, expectedResult)
+	}
+}
@@ -0,0 +1,316 @@
+package slices
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+)
+
+// The generators in this file return streams, so results are produced one by one when the stream is run
+// and are never materialised all at once unless Collect is called.
+// Use Take or return false from the ForEach callback to stop early.
+// Every yielded slice is newly allocated and can be kept by the caller.
+// Elements are distinguished by their position, not by their value, i.e., equal elements yield equal results.
+
+// pick
+// Returns a new slice containing the elements of slice at the provided indices.
+func pick[T any](slice []T, indices []int) []T {
+	ret := make([]T, len(indices))
+	for i, idx := range indices {
+		ret[i] = slice[idx]
+	}
+	return ret
+}
+
+// combinationIndices
+// Calls yield for all k-combinations of the indices [0, n-1] in lexicographic order.
+// Returns false if yield returned false.
+func combinationIndices(n int, k int, yield func([]int) bool) bool {
+	if k > n {
+		return true
+	}
+
+	indices := make([]int, k)
+	for i := range indices {
+		indices[i] = i
+	}
+
+	for {
+		if !yield(indices) {
+			return false
+		}
+
+		i := k - 1
+		for i >= 0 && indices[i] == n-k+i {
+			i--
+		}
+		if i < 0 {
+			return true
+		}
+
+		indices[i]++
+		for j := i + 1; j < k; j++ {
+			indices[j] = indices[j-1] + 1
+		}
+	}
+}
+
+// Permutations
+// Returns a stream of all permutations of slice in lexicographic order of the element positions.
+// The empty slice has exactly one permutation, the empty one.
+// Example:
+// Permutations([]int{1, 2, 3}).Collect() -> [[1 2 3] [1 3 2] [2 1 3] [2 3 1] [3 1 2] [3 2 1]]
+func Permutations[T any](slice []T) Stream[[]T] {
+	return Stream[[]T]{each: func(yield func([]T) bool) {
+		n := len(slice)
+		indices := make([]int, n)
+		for i := range indices {
+			indices[i] = i
+		}
+
+		for {
+			if !yield(pick(slice, indices)) {
+				return
+			}
+
+			// find the next permutation of the indices
+			i := n - 2
+			for i >= 0 && indices[i] > indices[i+1] {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+
+			j := n - 1
+			for indices[j] < indices[i] {
+				j--
+			}
+			indices[i], indices[j] = indices[j], indices[i]
+			ReverseInPlace(indices[i+1:])
+		}
+	}}
+}
+
+// Combinations
+// Returns a stream of all k-element combinations of slice in lexicographic order of the element positions.
+// Yields nothing if k is greater than the length of slice and exactly one empty combination if k is 0.
+// Returns ErrInvalidArgument if k is negative.
+// Example:
+// Combinations([]int{1, 2, 3}, 2) -> [[1 2] [1 3] [2 3]]
+func Combinations[T any](slice []T, k int) (Stream[[]T], error) {
+	if k < 0 {
+		return Stream[[]T]{}, fmt.Errorf("%w: k has to be non-negative but is %d", ErrInvalidArgument, k)
+	}
+
+	return Stream[[]T]{each: func(yield func([]T) bool) {
+		combinationIndices(len(slice), k, func(indices []int) bool {
+			return yield(pick(slice, indices))
+		})
+	}}, nil
+}
+
+// CombinationsWithReplacement
+// Returns a stream of all k-element combinations of slice where every element can be chosen multiple times,
+// in lexicographic order of the element positions.
+// Yields exactly one empty combination if k is 0.
+// Returns ErrInvalidArgument if k is negative.
+// Example:
+// CombinationsWithReplacement([]int{1, 2}, 2) -> [[1 1] [1 2] [2 2]]
+func CombinationsWithReplacement[T any](slice []T, k int) (Stream[[]T], error) {
+	if k < 0 {
+		return Stream[[]T]{}, fmt.Errorf("%w: k has to be non-negative but is %d", ErrInvalidArgument, k)
+	}
+
+	return Stream[[]T]{each: func(yield func([]T) bool) {
+		n := len(slice)
+		if n == 0 && k > 0 {
+			return
+		}
+
+		indices := make([]int, k)
+		for {
+			if !yield(pick(slice, indices)) {
+				return
+			}
+
+			i := k - 1
+			for i >= 0 && indices[i] == n-1 {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+
+			indices[i]++
+			for j := i + 1; j < k; j++ {
+				indices[j] = indices[i]
+			}
+		}
+	}}, nil
+}
+
+// PowerSet
+// Returns a stream of all subsets of slice, ordered by size and then lexicographically by the element positions.
+// Example:
+// PowerSet([]int{1, 2}) -> [[] [1] [2] [1 2]]
+func PowerSet[T any](slice []T) Stream[[]T] {
+	return Stream[[]T]{each: func(yield func([]T) bool) {
+		for k := 0; k <= len(slice); k++ {
+			if !combinationIndices(len(slice), k, func(indices []int) bool {
+				return yield(pick(slice, indices))
+			}) {
+				return
+			}
+		}
+	}}
+}
+
+// CartesianProduct
+// Returns a stream of all tuples containing one element of every provided slice, in the order of the slices.
+// The last slice varies fastest. Yields nothing if any slice is empty and exactly one empty tuple if no slice is provided.
+// Example:
+// CartesianProduct([]string{"a", "b"}, []string{"x", "y"}) -> [[a x] [a y] [b x] [b y]]
+func CartesianProduct[T any](slices ...[]T) Stream[[]T] {
+	return Stream[[]T]{each: func(yield func([]T) bool) {
+		for _, s := range slices {
+			if len(s) == 0 {
+				return
+			}
+		}
+
+		indices := make([]int, len(slices))
+		for {
+			tuple := make([]T, len(slices))
+			for i, idx := range indices {
+				tuple[i] = slices[i][idx]
+			}
+			if !yield(tuple) {
+				return
+			}
+
+			i := len(slices) - 1
+			for i >= 0 && indices[i] == len(slices[i])-1 {
+				indices[i] = 0
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			indices[i]++
+		}
+	}}
+}
+
+// countResult
+// Converts the provided count to an int.
+// Returns ErrInvalidArgument if it does not fit into an int.
+func countResult(count *big.Int) (int, error) {
+	if !count.IsInt64() || int64(int(count.Int64())) != count.Int64() {
+		return 0, fmt.Errorf("%w: count %s does not fit into an int", ErrInvalidArgument, count.String())
+	}
+	return int(count.Int64()), nil
+}
+
+// multiplyCount
+// Returns count * factor for non-negative values or ErrInvalidArgument if the product does not fit into an int.
+func multiplyCount(count int, factor int) (int, error) {
+	if factor != 0 && count > math.MaxInt/factor {
+		return 0, fmt.Errorf("%w: count %d * %d does not fit into an int", ErrInvalidArgument, count, factor)
+	}
+	return count * factor, nil
+}
+
+// checkNonNegative
+// Returns ErrInvalidArgument if any of the provided values is negative.
+func checkNonNegative(values ...int) error {
+	for _, v := range values {
+		if v < 0 {
+			return fmt.Errorf("%w: expected non-negative value but got %d", ErrInvalidArgument, v)
+		}
+	}
+	return nil
+}
+
+// CountPermutations
+// Returns the amount of permutations yielded by Permutations for a slice of length n, i.e., n!.
+// Returns ErrInvalidArgument if n is negative or the result does not fit into an int.
+func CountPermutations(n int) (int, error) {
+	if err := checkNonNegative(n); err != nil {
+		return 0, err
+	}
+	count := 1
+	for i := 2; i <= n; i++ {
+		var err error
+		if count, err = multiplyCount(count, i); err != nil {
+			return 0, err
+		}
+	}
+	return count, nil
+}
+
+// CountCombinations
+// Returns the amount of combinations yielded by Combinations for a slice of length n, i.e., n choose k.
+// Returns ErrInvalidArgument if n or k is negative or the result does not fit into an int.
+func CountCombinations(n int, k int) (int, error) {
+	if err := checkNonNegative(n, k); err != nil {
+		return 0, err
+	}
+	if k > n {
+		return 0, nil
+	}
+	return countResult(new(big.Int).Binomial(int64(n), int64(k)))
+}
+
+// CountCombinationsWithReplacement
+// Returns the amount of combinations yielded by CombinationsWithReplacement for a slice of length n,
+// i.e., (n+k-1) choose k.
+// Returns ErrInvalidArgument if n or k is negative or the result does not fit into an int.
+func CountCombinationsWithReplacement(n int, k int) (int, error) {
+	if err := checkNonNegative(n, k); err != nil {
+		return 0, err
+	}
+	if k == 0 {
+		return 1, nil
+	}
+	if n == 0 {
+		return 0, nil
+	}
+	return countResult(new(big.Int).Binomial(int64(n+k-1), int64(k)))
+}
+
+// CountPowerSet
+// Returns the amount of subsets yielded by PowerSet for a slice of length n, i.e., 2^n.
+// Returns ErrInvalidArgument if n is negative or the result does not fit into an int.
+func CountPowerSet(n int) (int, error) {
+	if err := checkNonNegative(n); err != nil {
+		return 0, err
+	}
+	if n >= strconv.IntSize-1 {
+		return 0, fmt.Errorf("%w: count 2^%d does not fit into an int", ErrInvalidArgument, n)
+	}
+	return 1 << n, nil
+}
+
+// CountCartesianProduct
+// Returns the amount of tuples yielded by CartesianProduct for slices of the provided lengths.
+// Returns ErrInvalidArgument if any length is negative or the result does not fit into an int.
+func CountCartesianProduct(lengths ...int) (int, error) {
+	if err := checkNonNegative(lengths...); err != nil {
+		return 0, err
+	}
+	for _, l := range lengths {
+		if l == 0 {
+			return 0, nil
+		}
+	}
+	count := 1
+	for _, l := range lengths {
+		var err error
+		if count, err = multiplyCount(count, l); err != nil {
+			return 0, err
+		}
+	}
+	return count, nil
+}
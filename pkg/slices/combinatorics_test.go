@@ -0,0 +1,162 @@
+package slices
+
+import (
+	"errors"
+	"fmt"
+	"github.com/rbnbr/go-utility/pkg/consts"
+	"testing"
+)
+
+// combinatoricsString
+// Formats the results of a generator for comparison.
+func combinatoricsString[T any](s Stream[[]T]) string {
+	return fmt.Sprint(s.Collect())
+}
+
+// TestPermutations
+// Tests Permutations including the empty slice and the count.
+func TestPermutations(t *testing.T) {
+	expectedResult := "[[1 2 3] [1 3 2] [2 1 3] [2 3 1] [3 1 2] [3 2 1]]"
+	gotResult := combinatoricsString(Permutations([]int{1, 2, 3}))
+	if gotResult != expectedResult {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	expectedResult = "[[]]"
+	gotResult = combinatoricsString(Permutations([]int{}))
+	if gotResult != expectedResult {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	gotCount, _ := CountPermutations(5)
+	if gotCount != Permutations(make([]int, 5)).Count() || gotCount != 120 {
+		t.Errorf(consts.GotExpectedResultFmt, gotCount, 120)
+	}
+}
+
+// TestCombinations
+// Tests Combinations and CombinationsWithReplacement including edge cases and the counts.
+func TestCombinations(t *testing.T) {
+	testSlice := []string{"a", "b", "c", "d"}
+
+	expectedResult := "[[a b] [a c] [a d] [b c] [b d] [c d]]"
+	combinations, _ := Combinations(testSlice, 2)
+	gotResult := combinatoricsString(combinations)
+	if gotResult != expectedResult {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	expectedResult = "[[a a] [a b] [a c] [b b] [b c] [c c]]"
+	combinations, _ = CombinationsWithReplacement(testSlice[:3], 2)
+	gotResult = combinatoricsString(combinations)
+	if gotResult != expectedResult {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	for k := 0; k <= 5; k++ {
+		combinations, _ = Combinations(testSlice, k)
+		gotCount, _ := CountCombinations(len(testSlice), k)
+		if gotCount != combinations.Count() {
+			t.Errorf(consts.GotExpectedResultFmt, gotCount, combinations.Count())
+		}
+
+		combinations, _ = CombinationsWithReplacement(testSlice, k)
+		gotCount, _ = CountCombinationsWithReplacement(len(testSlice), k)
+		if gotCount != combinations.Count() {
+			t.Errorf(consts.GotExpectedResultFmt, gotCount, combinations.Count())
+		}
+	}
+
+	_, gotError := Combinations(testSlice, -1)
+	if !errors.Is(gotError, ErrInvalidArgument) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, ErrInvalidArgument)
+	}
+}
+
+// TestPowerSet
+// Tests PowerSet and its count.
+func TestPowerSet(t *testing.T) {
+	expectedResult := "[[] [1] [2] [3] [1 2] [1 3] [2 3] [1 2 3]]"
+	gotResult := combinatoricsString(PowerSet([]int{1, 2, 3}))
+	if gotResult != expectedResult {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	gotCount, _ := CountPowerSet(10)
+	if gotCount != PowerSet(make([]int, 10)).Count() {
+		t.Errorf(consts.GotExpectedResultFmt, gotCount, 1024)
+	}
+
+	_, gotError := CountPowerSet(200)
+	if !errors.Is(gotError, ErrInvalidArgument) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, ErrInvalidArgument)
+	}
+}
+
+// TestCartesianProduct
+// Tests CartesianProduct including empty inputs and the count.
+func TestCartesianProduct(t *testing.T) {
+	expectedResult := "[[a x] [a y] [b x] [b y] [c x] [c y]]"
+	gotResult := combinatoricsString(CartesianProduct([]string{"a", "b", "c"}, []string{"x", "y"}))
+	if gotResult != expectedResult {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	expectedResult = "[]"
+	gotResult = combinatoricsString(CartesianProduct([]string{"a"}, []string{}))
+	if gotResult != expectedResult {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	gotCount, _ := CountCartesianProduct(3, 4, 5)
+	if gotCount != CartesianProduct(make([]int, 3), make([]int, 4), make([]int, 5)).Count() {
+		t.Errorf(consts.GotExpectedResultFmt, gotCount, 60)
+	}
+}
+
+// TestCount_overflow
+// Tests that the counts fail fast for huge inputs and that a zero length wins over an overflowing product.
+func TestCount_overflow(t *testing.T) {
+	_, gotError := CountPowerSet(1 << 30)
+	if !errors.Is(gotError, ErrInvalidArgument) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, ErrInvalidArgument)
+	}
+
+	_, gotError = CountPermutations(1_000_000)
+	if !errors.Is(gotError, ErrInvalidArgument) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, ErrInvalidArgument)
+	}
+
+	_, gotError = CountCartesianProduct(1<<30, 1<<30, 1<<30)
+	if !errors.Is(gotError, ErrInvalidArgument) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, ErrInvalidArgument)
+	}
+
+	gotCount, gotError := CountCartesianProduct(1<<30, 1<<30, 0)
+	if !errors.Is(gotError, ErrNil) || gotCount != 0 {
+		t.Errorf(consts.GotExpectedResultFmt, gotCount, 0)
+	}
+
+	gotCount, _ = CountPermutations(12)
+	if gotCount != 479001600 {
+		t.Errorf(consts.GotExpectedResultFmt, gotCount, 479001600)
+	}
+}
+
+// TestCombinatorics_earlyStop
+// Tests that the generators stop as soon as the consumer does.
+func TestCombinatorics_earlyStop(t *testing.T) {
+	generated := 0
+	Permutations(make([]int, 20)).ForEach(func(p []int) bool {
+		generated++
+		return generated < 3
+	})
+	if generated != 3 {
+		t.Errorf(consts.GotExpectedResultFmt, generated, 3)
+	}
+
+	gotResult := PowerSet(make([]int, 40)).Take(5).Count()
+	if gotResult != 5 {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, 5)
+	}
+}
@@ -0,0 +1,337 @@
+package slices
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EditKind
+// Kind of operation of an Edit.
+type EditKind int
+
+const (
+	EditKeep    EditKind = iota // the element is contained in both slices
+	EditDelete                  // the element of the old slice has been removed
+	EditInsert                  // the element of the new slice has been added
+	EditReplace                 // the element of the old slice has been replaced by the element of the new slice
+)
+
+func (k EditKind) String() string {
+	switch k {
+	case EditKeep:
+		return "Keep"
+	case EditDelete:
+		return "Delete"
+	case EditInsert:
+		return "Insert"
+	case EditReplace:
+		return "Replace"
+	default:
+		return fmt.Sprintf("EditKind(%d)", int(k))
+	}
+}
+
+// Edit
+// A single operation of an edit script returned by Diff.
+// OldIndex is the index of the affected element in the old slice, or -1 for EditInsert.
+// NewIndex is the index of the affected element in the new slice, or -1 for EditDelete.
+type Edit struct {
+	Kind     EditKind
+	OldIndex int
+	NewIndex int
+}
+
+// myers
+// Returns the shortest edit script consisting only of EditKeep, EditDelete and EditInsert operations
+// which transforms a into b, using the linear space variant of Myers' O((N+M)D) algorithm.
+// Instead of keeping the search state of every round for backtracking, which needs O((N+M)D) memory,
+// it searches for the middle snake of an optimal path and recurses on both halves, which needs O(N+M) memory.
+func myers[T1 any, T2 any](a []T1, b []T2, predicate func(T1, T2) bool) []Edit {
+	edits := make([]Edit, 0, len(a)+len(b))
+	myersCompare(a, b, predicate, 0, len(a), 0, len(b), &edits)
+	return edits
+}
+
+// myersCompare
+// Appends the shortest edit script transforming a[aLo:aHi] into b[bLo:bHi] to edits.
+func myersCompare[T1 any, T2 any](a []T1, b []T2, predicate func(T1, T2) bool, aLo, aHi, bLo, bHi int, edits *[]Edit) {
+	// common prefix
+	for aLo < aHi && bLo < bHi && predicate(a[aLo], b[bLo]) {
+		*edits = append(*edits, Edit{Kind: EditKeep, OldIndex: aLo, NewIndex: bLo})
+		aLo++
+		bLo++
+	}
+
+	// common suffix, appended after the middle part
+	suffix := 0
+	for aLo < aHi-suffix && bLo < bHi-suffix && predicate(a[aHi-suffix-1], b[bHi-suffix-1]) {
+		suffix++
+	}
+	aHi -= suffix
+	bHi -= suffix
+
+	switch {
+	case aLo == aHi:
+		for y := bLo; y < bHi; y++ {
+			*edits = append(*edits, Edit{Kind: EditInsert, OldIndex: -1, NewIndex: y})
+		}
+	case bLo == bHi:
+		for x := aLo; x < aHi; x++ {
+			*edits = append(*edits, Edit{Kind: EditDelete, OldIndex: x, NewIndex: -1})
+		}
+	default:
+		x, y := myersMiddleSnake(a[aLo:aHi], b[bLo:bHi], predicate)
+		myersCompare(a, b, predicate, aLo, aLo+x, bLo, bLo+y, edits)
+		myersCompare(a, b, predicate, aLo+x, aHi, bLo+y, bHi, edits)
+	}
+
+	for i := 0; i < suffix; i++ {
+		*edits = append(*edits, Edit{Kind: EditKeep, OldIndex: aHi + i, NewIndex: bHi + i})
+	}
+}
+
+// myersMiddleSnake
+// Returns the point (x, y) at which an optimal path from (0, 0) to (len(a), len(b)) can be split,
+// by running the search forwards from (0, 0) and backwards from (len(a), len(b)) until both meet.
+// a and b must not be empty and must not share a common prefix or suffix.
+func myersMiddleSnake[T1 any, T2 any](a []T1, b []T2, predicate func(T1, T2) bool) (int, int) {
+	n, m := len(a), len(b)
+	maxD := (n + m + 1) / 2
+	offset := maxD
+	length := 2*maxD + 2
+
+	// forward[offset+k] is the furthest x reached on diagonal k = x - y from the start,
+	// backward[offset+k] is the furthest distance from the end reached on the mirrored diagonal k
+	forward := make([]int, length)
+	backward := make([]int, length)
+	for i := range forward {
+		forward[i] = -1
+		backward[i] = -1
+	}
+	forward[offset+1] = 0
+	backward[offset+1] = 0
+
+	delta := n - m
+	// if delta is odd, the paths can only meet during the forward search, else during the backward search
+	front := delta%2 != 0
+
+	// bounds of the diagonals which still lie within the edit graph
+	kForwardStart, kForwardEnd, kBackwardStart, kBackwardEnd := 0, 0, 0, 0
+
+	for d := 0; d <= maxD; d++ {
+		for k := -d + kForwardStart; k <= d-kForwardEnd; k += 2 {
+			i := offset + k
+			var x int
+			if k == -d || (k != d && forward[i-1] < forward[i+1]) {
+				x = forward[i+1]
+			} else {
+				x = forward[i-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && predicate(a[x], b[y]) {
+				x++
+				y++
+			}
+			forward[i] = x
+
+			switch {
+			case x > n:
+				kForwardEnd += 2
+			case y > m:
+				kForwardStart += 2
+			case front:
+				j := offset + delta - k
+				if j >= 0 && j < length && backward[j] != -1 && x >= n-backward[j] {
+					return x, y
+				}
+			}
+		}
+
+		for k := -d + kBackwardStart; k <= d-kBackwardEnd; k += 2 {
+			i := offset + k
+			var x int
+			if k == -d || (k != d && backward[i-1] < backward[i+1]) {
+				x = backward[i+1]
+			} else {
+				x = backward[i-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && predicate(a[n-x-1], b[m-y-1]) {
+				x++
+				y++
+			}
+			backward[i] = x
+
+			switch {
+			case x > n:
+				kBackwardEnd += 2
+			case y > m:
+				kBackwardStart += 2
+			case !front:
+				j := offset + delta - k
+				if j >= 0 && j < length && forward[j] != -1 {
+					forwardX := forward[j]
+					forwardY := forwardX - (j - offset)
+					if forwardX >= n-x {
+						return forwardX, forwardY
+					}
+				}
+			}
+		}
+	}
+
+	// not reachable for inputs without a common prefix or suffix,
+	// splitting after the first element of a still yields a valid edit script
+	return 1, 0
+}
+
+// mergeReplacements
+// Replaces pairs of deletions and insertions within each run of consecutive changes by EditReplace operations.
+// Within a run, the replacements come first, followed by the remaining deletions or insertions.
+func mergeReplacements(edits []Edit) []Edit {
+	ret := make([]Edit, 0, len(edits))
+
+	for i := 0; i < len(edits); {
+		if edits[i].Kind == EditKeep {
+			ret = append(ret, edits[i])
+			i++
+			continue
+		}
+
+		deletes := make([]int, 0)
+		inserts := make([]int, 0)
+		for ; i < len(edits) && edits[i].Kind != EditKeep; i++ {
+			if edits[i].Kind == EditDelete {
+				deletes = append(deletes, edits[i].OldIndex)
+			} else {
+				inserts = append(inserts, edits[i].NewIndex)
+			}
+		}
+
+		j := 0
+		for ; j < len(deletes) && j < len(inserts); j++ {
+			ret = append(ret, Edit{Kind: EditReplace, OldIndex: deletes[j], NewIndex: inserts[j]})
+		}
+		for _, d := range deletes[j:] {
+			ret = append(ret, Edit{Kind: EditDelete, OldIndex: d, NewIndex: -1})
+		}
+		for _, in := range inserts[j:] {
+			ret = append(ret, Edit{Kind: EditInsert, OldIndex: -1, NewIndex: in})
+		}
+	}
+
+	return ret
+}
+
+// DiffConfigurable
+// Returns a shortest edit script which transforms the slice a into the slice b.
+// As with Equal, the predicate decides whether an element of a equals an element of b.
+// If replace is true, deletions directly followed by insertions are merged to EditReplace operations.
+// Applying the edits in order, i.e., keeping, deleting, inserting or replacing elements, yields b from a.
+func DiffConfigurable[T1 any, T2 any](a []T1, b []T2, predicate func(T1, T2) bool, replace bool) []Edit {
+	edits := myers(a, b, predicate)
+	if replace {
+		return mergeReplacements(edits)
+	}
+	return edits
+}
+
+// Diff
+// Shorthand for DiffConfigurable(a, b, predicate, true)
+func Diff[T1 any, T2 any](a []T1, b []T2, predicate func(T1, T2) bool) []Edit {
+	return DiffConfigurable(a, b, predicate, true)
+}
+
+// LongestCommonSubsequence
+// Returns a longest sequence of elements of a which also occur in b in the same order, not necessarily consecutively.
+// As with Equal, the predicate decides whether an element of a equals an element of b.
+func LongestCommonSubsequence[T1 any, T2 any](a []T1, b []T2, predicate func(T1, T2) bool) []T1 {
+	ret := make([]T1, 0)
+	for _, e := range myers(a, b, predicate) {
+		if e.Kind == EditKeep {
+			ret = append(ret, a[e.OldIndex])
+		}
+	}
+	return ret
+}
+
+// UnifiedDiff
+// Renders the differences between the lines a and b in the unified diff format,
+// using fromName and toName as file names in the header and showing context unchanged lines around each change.
+// Returns an empty string if a and b are equal.
+func UnifiedDiff(a []string, b []string, fromName string, toName string, context int) string {
+	edits := myers(a, b, func(s1 string, s2 string) bool {
+		return s1 == s2
+	})
+
+	if !ContainsGeneric(edits, func(e Edit) bool {
+		return e.Kind != EditKeep
+	}) {
+		return ""
+	}
+	if context < 0 {
+		context = 0
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", fromName, toName)
+
+	// old and new line numbers consumed before each edit
+	oldLine, newLine := make([]int, len(edits)+1), make([]int, len(edits)+1)
+	for i, e := range edits {
+		oldLine[i+1], newLine[i+1] = oldLine[i], newLine[i]
+		if e.Kind != EditInsert {
+			oldLine[i+1]++
+		}
+		if e.Kind != EditDelete {
+			newLine[i+1]++
+		}
+	}
+
+	for i := 0; i < len(edits); {
+		if edits[i].Kind == EditKeep {
+			i++
+			continue
+		}
+
+		// extend the hunk as long as the next change is close enough to share the context
+		start := i - context
+		if start < 0 {
+			start = 0
+		}
+		end := i
+		for j := i; j < len(edits) && j <= end+2*context+1; j++ {
+			if edits[j].Kind != EditKeep {
+				end = j
+			}
+		}
+		i = end + 1
+		end += context
+		if end >= len(edits) {
+			end = len(edits) - 1
+		}
+
+		oldCount, newCount := oldLine[end+1]-oldLine[start], newLine[end+1]-newLine[start]
+		oldStart, newStart := oldLine[start]+1, newLine[start]+1
+		if oldCount == 0 {
+			oldStart--
+		}
+		if newCount == 0 {
+			newStart--
+		}
+		fmt.Fprintf(&sb, "@@ -%d,%d +%d,%d @@\n", oldStart, oldCount, newStart, newCount)
+
+		for _, e := range edits[start : end+1] {
+			switch e.Kind {
+			case EditKeep:
+				fmt.Fprintf(&sb, " %s\n", a[e.OldIndex])
+			case EditDelete:
+				fmt.Fprintf(&sb, "-%s\n", a[e.OldIndex])
+			case EditInsert:
+				fmt.Fprintf(&sb, "+%s\n", b[e.NewIndex])
+			}
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,209 @@
+package slices
+
+import (
+	"github.com/rbnbr/go-utility/pkg/consts"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// applyEdits
+// Applies the edit script to a and returns the result.
+func applyEdits(a []string, b []string, edits []Edit) []string {
+	ret := make([]string, 0)
+	for _, e := range edits {
+		switch e.Kind {
+		case EditKeep:
+			ret = append(ret, a[e.OldIndex])
+		case EditInsert, EditReplace:
+			ret = append(ret, b[e.NewIndex])
+		}
+	}
+	return ret
+}
+
+// TestDiff
+// Tests that the edit script transforms the old into the new slice and is minimal.
+func TestDiff(t *testing.T) {
+	testCases := [][2][]string{
+		{strings.Split("ABCABBA", ""), strings.Split("CBABAC", "")},
+		{{}, {"a", "b"}},
+		{{"a", "b"}, {}},
+		{{"a", "b", "c"}, {"a", "x", "c"}},
+		{{}, {}},
+	}
+	equal := func(a, b string) bool {
+		return a == b
+	}
+
+	for _, tc := range testCases {
+		for _, replace := range []bool{false, true} {
+			edits := DiffConfigurable(tc[0], tc[1], equal, replace)
+			gotResult := applyEdits(tc[0], tc[1], edits)
+			if !Equal(gotResult, tc[1], equal) {
+				t.Errorf(consts.GotExpectedResultFmt, gotResult, tc[1])
+			}
+		}
+	}
+
+	// the classic example of Myers' paper has an edit distance of 5
+	expectedResult := 5
+	gotResult := CountGeneric(DiffConfigurable(testCases[0][0], testCases[0][1], equal, false), func(e Edit) bool {
+		return e.Kind != EditKeep
+	})
+	if gotResult != expectedResult {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	expectedEdits := []Edit{{EditKeep, 0, 0}, {EditReplace, 1, 1}, {EditKeep, 2, 2}}
+	gotEdits := Diff(testCases[3][0], testCases[3][1], equal)
+	if !Equal(gotEdits, expectedEdits, func(e1 Edit, e2 Edit) bool {
+		return e1 == e2
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotEdits, expectedEdits)
+	}
+}
+
+// lcsLength
+// Returns the length of the longest common subsequence of a and b using quadratic dynamic programming.
+func lcsLength(a []string, b []string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for i := range a {
+		for j := range b {
+			switch {
+			case a[i] == b[j]:
+				curr[j+1] = prev[j] + 1
+			case prev[j+1] > curr[j]:
+				curr[j+1] = prev[j+1]
+			default:
+				curr[j+1] = curr[j]
+			}
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+// TestDiff_random
+// Tests that the edit script is valid and minimal for random inputs by comparing it to dynamic programming.
+func TestDiff_random(t *testing.T) {
+	rng := rand.New(rand.NewSource(42))
+	equal := func(a, b string) bool {
+		return a == b
+	}
+	randomSlice := func() []string {
+		ret := make([]string, rng.Intn(30))
+		for i := range ret {
+			ret[i] = strconv.Itoa(rng.Intn(4))
+		}
+		return ret
+	}
+
+	for i := 0; i < 500; i++ {
+		a, b := randomSlice(), randomSlice()
+		edits := DiffConfigurable(a, b, equal, false)
+
+		gotResult := applyEdits(a, b, edits)
+		if !Equal(gotResult, b, equal) {
+			t.Fatalf(consts.GotExpectedResultFmt, gotResult, b)
+		}
+
+		expectedChanges := len(a) + len(b) - 2*lcsLength(a, b)
+		gotChanges := CountGeneric(edits, func(e Edit) bool {
+			return e.Kind != EditKeep
+		})
+		if gotChanges != expectedChanges {
+			t.Fatalf(consts.GotExpectedResultFmt, gotChanges, expectedChanges)
+		}
+	}
+}
+
+// divergentSlices
+// Returns two slices of n elements each which do not share any element.
+func divergentSlices(n int) ([]int, []int) {
+	a, b := make([]int, n), make([]int, n)
+	for i := range a {
+		a[i] = i
+		b[i] = n + i
+	}
+	return a, b
+}
+
+// TestDiff_divergent
+// Tests that large fully divergent inputs are diffed in linear memory.
+func TestDiff_divergent(t *testing.T) {
+	a, b := divergentSlices(3000)
+	equal := func(x, y int) bool {
+		return x == y
+	}
+
+	var edits []Edit
+	allocs := testing.AllocsPerRun(1, func() {
+		edits = DiffConfigurable(a, b, equal, false)
+	})
+
+	if len(edits) != 6000 {
+		t.Errorf(consts.GotExpectedResultFmt, len(edits), 6000)
+	}
+	// keeping a snapshot of the search state for every round would allocate thousands of times for this input
+	if allocs > 100 {
+		t.Errorf(consts.GotExpectedResultFmt, allocs, "at most 100 allocations")
+	}
+}
+
+func BenchmarkDiff_divergent(b *testing.B) {
+	x, y := divergentSlices(3000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Diff(x, y, func(v, w int) bool {
+			return v == w
+		})
+	}
+}
+
+// TestLongestCommonSubsequence
+// Tests the LongestCommonSubsequence function with slices of different types.
+func TestLongestCommonSubsequence(t *testing.T) {
+	testA := []int{1, 2, 3, 4, 5, 6}
+	testB := []string{"2", "4", "7", "6"}
+
+	expectedResult := []int{2, 4, 6}
+	gotResult := LongestCommonSubsequence(testA, testB, func(i int, s string) bool {
+		return string(rune('0'+i)) == s
+	})
+	if !Equal(gotResult, expectedResult, func(a, b int) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+}
+
+// TestUnifiedDiff
+// Tests the rendering of separate and merged hunks.
+func TestUnifiedDiff(t *testing.T) {
+	testA := []string{"a", "b", "c", "d", "e", "f", "g", "h", "i"}
+	testB := []string{"a", "B", "c", "d", "e", "f", "g", "h"}
+
+	expectedResult := "--- old\n+++ new\n" +
+		"@@ -1,3 +1,3 @@\n a\n-b\n+B\n c\n" +
+		"@@ -8,2 +8,1 @@\n h\n-i\n"
+	gotResult := UnifiedDiff(testA, testB, "old", "new", 1)
+	if gotResult != expectedResult {
+		t.Errorf(consts.GotExpectedDiffFmt, UnifiedDiff(strings.Split(expectedResult, "\n"), strings.Split(gotResult, "\n"), "expected", "got", 1))
+	}
+
+	expectedResult = "--- old\n+++ new\n" +
+		"@@ -1,9 +1,8 @@\n a\n-b\n+B\n c\n d\n e\n f\n g\n h\n-i\n"
+	gotResult = UnifiedDiff(testA, testB, "old", "new", 3)
+	if gotResult != expectedResult {
+		t.Errorf(consts.GotExpectedDiffFmt, UnifiedDiff(strings.Split(expectedResult, "\n"), strings.Split(gotResult, "\n"), "expected", "got", 1))
+	}
+
+	gotResult = UnifiedDiff(testA, testA, "old", "new", 3)
+	if gotResult != "" {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, "")
+	}
+}
@@ -0,0 +1,149 @@
+package slices
+
+import (
+	"container/heap"
+	"fmt"
+)
+
+// ArgMin
+// Returns the index of the smallest element according to the comparator, the first one if there are several.
+// Returns ErrInvalidArgument if slice is empty.
+func ArgMin[T any](slice []T, comparator Comparator[T]) (int, error) {
+	if len(slice) == 0 {
+		return -1, fmt.Errorf("%w: the minimum of an empty slice is undefined", ErrInvalidArgument)
+	}
+
+	ret := 0
+	for i := 1; i < len(slice); i++ {
+		if comparator(slice[i], slice[ret]) < 0 {
+			ret = i
+		}
+	}
+	return ret, nil
+}
+
+// ArgMax
+// Returns the index of the largest element according to the comparator, the first one if there are several.
+// Returns ErrInvalidArgument if slice is empty.
+func ArgMax[T any](slice []T, comparator Comparator[T]) (int, error) {
+	if len(slice) == 0 {
+		return -1, fmt.Errorf("%w: the maximum of an empty slice is undefined", ErrInvalidArgument)
+	}
+
+	ret := 0
+	for i := 1; i < len(slice); i++ {
+		if comparator(slice[i], slice[ret]) > 0 {
+			ret = i
+		}
+	}
+	return ret, nil
+}
+
+// ArgMinKey
+// Shorthand for ArgMin(slice, By(accessor))
+func ArgMinKey[T any, K Ordered](slice []T, accessor func(T) K) (int, error) {
+	return ArgMin(slice, By(accessor))
+}
+
+// ArgMaxKey
+// Shorthand for ArgMax(slice, By(accessor))
+func ArgMaxKey[T any, K Ordered](slice []T, accessor func(T) K) (int, error) {
+	return ArgMax(slice, By(accessor))
+}
+
+// MinBy
+// Returns the smallest element according to the comparator, see ArgMin.
+func MinBy[T any](slice []T, comparator Comparator[T]) (T, error) {
+	i, err := ArgMin(slice, comparator)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return slice[i], nil
+}
+
+// MaxBy
+// Returns the largest element according to the comparator, see ArgMax.
+func MaxBy[T any](slice []T, comparator Comparator[T]) (T, error) {
+	i, err := ArgMax(slice, comparator)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return slice[i], nil
+}
+
+// MinByKey
+// Shorthand for MinBy(slice, By(accessor))
+func MinByKey[T any, K Ordered](slice []T, accessor func(T) K) (T, error) {
+	return MinBy(slice, By(accessor))
+}
+
+// MaxByKey
+// Shorthand for MaxBy(slice, By(accessor))
+func MaxByKey[T any, K Ordered](slice []T, accessor func(T) K) (T, error) {
+	return MaxBy(slice, By(accessor))
+}
+
+// boundedHeap
+// Min-heap of indices into slice holding the k best elements seen so far, with the worst of them on top.
+// An element is better than another if it is greater according to comparator or, if equal, has the smaller index.
+type boundedHeap[T any] struct {
+	slice      []T
+	comparator Comparator[T]
+	indices    []int
+}
+
+func (h *boundedHeap[T]) better(i, j int) bool {
+	c := h.comparator(h.slice[i], h.slice[j])
+	return c > 0 || (c == 0 && i < j)
+}
+
+func (h *boundedHeap[T]) Len() int           { return len(h.indices) }
+func (h *boundedHeap[T]) Less(a, b int) bool { return h.better(h.indices[b], h.indices[a]) }
+func (h *boundedHeap[T]) Swap(a, b int)      { h.indices[a], h.indices[b] = h.indices[b], h.indices[a] }
+func (h *boundedHeap[T]) Push(x any)         { h.indices = append(h.indices, x.(int)) }
+func (h *boundedHeap[T]) Pop() any {
+	last := h.indices[len(h.indices)-1]
+	h.indices = h.indices[:len(h.indices)-1]
+	return last
+}
+
+// TopK
+// Returns the k largest elements according to the comparator, largest first, in O(n log k) using a bounded heap.
+// Equal elements are ordered by their index, i.e., the element occurring first ranks higher.
+// Returns all elements if k is larger than len(slice).
+// Returns ErrInvalidArgument if slice is empty or k is negative.
+func TopK[T any](slice []T, k int, comparator Comparator[T]) ([]T, error) {
+	if len(slice) == 0 {
+		return nil, fmt.Errorf("%w: the top k of an empty slice are undefined", ErrInvalidArgument)
+	}
+	if k < 0 {
+		return nil, fmt.Errorf("%w: k has to be at least 0 but is %d", ErrInvalidArgument, k)
+	}
+	if k > len(slice) {
+		k = len(slice)
+	}
+
+	h := &boundedHeap[T]{slice: slice, comparator: comparator, indices: make([]int, 0, k+1)}
+	for i := range slice {
+		if h.Len() < k {
+			heap.Push(h, i)
+		} else if k > 0 && h.better(i, h.indices[0]) {
+			h.indices[0] = i
+			heap.Fix(h, 0)
+		}
+	}
+
+	ret := make([]T, h.Len())
+	for i := len(ret) - 1; i >= 0; i-- {
+		ret[i] = slice[heap.Pop(h).(int)]
+	}
+	return ret, nil
+}
+
+// BottomK
+// Returns the k smallest elements according to the comparator, smallest first, see TopK.
+func BottomK[T any](slice []T, k int, comparator Comparator[T]) ([]T, error) {
+	return TopK(slice, k, comparator.Reverse())
+}
@@ -0,0 +1,101 @@
+package slices
+
+import (
+	"errors"
+	"github.com/rbnbr/go-utility/pkg/consts"
+	"testing"
+)
+
+// TestMinMaxBy
+// Tests the ArgMin, ArgMax, MinBy and MaxBy functions including ties and the empty slice error.
+func TestMinMaxBy(t *testing.T) {
+	testSlice := []testEmployee{{"Amy", "A", 300}, {"Bob", "A", 100}, {"Cid", "B", 300}, {"Dan", "B", 100}}
+	salary := func(e testEmployee) int {
+		return e.Salary
+	}
+
+	gotIndex, _ := ArgMinKey(testSlice, salary)
+	if gotIndex != 1 {
+		t.Errorf(consts.GotExpectedResultFmt, gotIndex, 1)
+	}
+
+	gotIndex, _ = ArgMaxKey(testSlice, salary)
+	if gotIndex != 0 {
+		t.Errorf(consts.GotExpectedResultFmt, gotIndex, 0)
+	}
+
+	gotMax, _ := MaxBy(testSlice, By(salary).ThenBy(By(func(e testEmployee) string {
+		return e.Name
+	})))
+	if gotMax.Name != "Cid" {
+		t.Errorf(consts.GotExpectedResultFmt, gotMax.Name, "Cid")
+	}
+
+	gotMin, _ := MinByKey(testSlice, func(e testEmployee) string {
+		return e.Name
+	})
+	if gotMin.Name != "Amy" {
+		t.Errorf(consts.GotExpectedResultFmt, gotMin.Name, "Amy")
+	}
+
+	_, gotError := MinBy([]int{}, CompareNatural[int])
+	if !errors.Is(gotError, ErrInvalidArgument) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, ErrInvalidArgument)
+	}
+}
+
+// TestTopK
+// Tests TopK and BottomK against a full sort, including ties and k out of range.
+func TestTopK(t *testing.T) {
+	testSlice := []int{5, 1, 9, 3, 9, 7, 1, 8, 2, 5}
+	equal := func(a, b int) bool {
+		return a == b
+	}
+
+	expectedResult := []int{9, 9, 8, 7}
+	gotResult, _ := TopK(testSlice, 4, CompareNatural[int])
+	if !Equal(gotResult, expectedResult, equal) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	expectedResult = []int{1, 1, 2}
+	gotResult, _ = BottomK(testSlice, 3, CompareNatural[int])
+	if !Equal(gotResult, expectedResult, equal) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	expectedResult = Sorted(testSlice, Natural[int]().Reverse())
+	gotResult, _ = TopK(testSlice, 100, CompareNatural[int])
+	if !Equal(gotResult, expectedResult, equal) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	gotResult, _ = TopK(testSlice, 0, CompareNatural[int])
+	if len(gotResult) != 0 {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, []int{})
+	}
+
+	_, gotError := TopK(testSlice, -1, CompareNatural[int])
+	if !errors.Is(gotError, ErrInvalidArgument) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, ErrInvalidArgument)
+	}
+}
+
+// TestTopK_ties
+// Tests that equal elements are ordered by their first occurrence.
+func TestTopK_ties(t *testing.T) {
+	testSlice := []testEmployee{{"Amy", "A", 100}, {"Bob", "A", 300}, {"Cid", "B", 300}, {"Dan", "B", 300}}
+
+	expectedResult := []string{"Bob", "Cid"}
+	gotTop, _ := TopK(testSlice, 2, By(func(e testEmployee) int {
+		return e.Salary
+	}))
+	gotResult := Map(gotTop, func(e *testEmployee) string {
+		return e.Name
+	})
+	if !Equal(gotResult, expectedResult, func(a, b string) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+}
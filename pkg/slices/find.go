@@ -0,0 +1,192 @@
+package slices
+
+// FindLastIndex
+// Returns the index for which the provided predicate evaluates true last, i.e., searches backwards from limit-1 to 0.
+// Returns -1 if it didn't evaluate true at all.
+// Similar usage as FindIndex
+func FindLastIndex(limit int, predicate func(int) bool) int {
+	for i := limit - 1; i >= 0; i-- {
+		if predicate(i) {
+			return i
+		}
+	}
+	return -1
+}
+
+// FindIndexFrom
+// Same as FindIndex but the search space for the indices is [start, limit-1].
+// No bounds check included.
+func FindIndexFrom(start int, limit int, predicate func(int) bool) int {
+	for i := start; i < limit; i++ {
+		if predicate(i) {
+			return i
+		}
+	}
+	return -1
+}
+
+// FindAllIndices
+// Returns all indices in [0, limit-1] for which the provided predicate evaluates true, in ascending order.
+// Similar usage as FindIndex
+func FindAllIndices(limit int, predicate func(int) bool) []int {
+	ret := make([]int, 0)
+	for i := 0; i < limit; i++ {
+		if predicate(i) {
+			ret = append(ret, i)
+		}
+	}
+	return ret
+}
+
+// FindNth
+// Returns the index for which the provided predicate evaluates true for the n-th time, counting from 0,
+// i.e., FindNth(limit, 0, predicate) equals FindIndex(limit, predicate).
+// Returns -1 if it evaluated true n times or less.
+// Similar usage as FindIndex
+func FindNth(limit int, n int, predicate func(int) bool) int {
+	if n < 0 {
+		return -1
+	}
+	for i := 0; i < limit; i++ {
+		if predicate(i) {
+			if n == 0 {
+				return i
+			}
+			n--
+		}
+	}
+	return -1
+}
+
+// ContainsRange
+// Returns true if the predicate evaluates true for any index in [start, end-1].
+// No bounds check included.
+func ContainsRange(start int, end int, predicate func(int) bool) bool {
+	return FindIndexFrom(start, end, predicate) != -1
+}
+
+// CountRange
+// Returns the amount of indices in [start, end-1] for which the predicate did evaluate true.
+// No bounds check included.
+func CountRange(start int, end int, predicate func(int) bool) int {
+	count := 0
+	for i := start; i < end; i++ {
+		if predicate(i) {
+			count += 1
+		}
+	}
+	return count
+}
+
+// clampRange
+// Restricts [start, end) to the valid indices [0, n).
+func clampRange(start int, end int, n int) (int, int) {
+	if start < 0 {
+		start = 0
+	}
+	if end > n {
+		end = n
+	}
+	return start, end
+}
+
+// FindLastIndexGeneric
+// Returns the last index for which the provided predicate evaluates true on the element.
+// Returns -1 if it didn't evaluate true at all.
+// Similar usage as FindIndexGeneric
+func FindLastIndexGeneric[T any](slice []T, predicate func(T) bool) int {
+	return FindLastIndex(len(slice), func(i int) bool {
+		return predicate(slice[i])
+	})
+}
+
+// FindIndexFromGeneric
+// Same as FindIndexGeneric but starts the search at index start.
+// Returns -1 if start is out of bounds, i.e., negative or not smaller than the length of slice.
+func FindIndexFromGeneric[T any](slice []T, start int, predicate func(T) bool) int {
+	if start < 0 {
+		return -1
+	}
+	return FindIndexFrom(start, len(slice), func(i int) bool {
+		return predicate(slice[i])
+	})
+}
+
+// FindAllIndicesGeneric
+// Returns all indices for which the provided predicate evaluates true on the element, in ascending order.
+// Similar usage as FindIndexGeneric
+func FindAllIndicesGeneric[T any](slice []T, predicate func(T) bool) []int {
+	return FindAllIndices(len(slice), func(i int) bool {
+		return predicate(slice[i])
+	})
+}
+
+// FindNthGeneric
+// Returns the index for which the provided predicate evaluates true on the element for the n-th time, counting from 0.
+// Returns -1 if it evaluated true n times or less.
+// Similar usage as FindIndexGeneric
+func FindNthGeneric[T any](slice []T, n int, predicate func(T) bool) int {
+	return FindNth(len(slice), n, func(i int) bool {
+		return predicate(slice[i])
+	})
+}
+
+// Find
+// Returns the first element for which the provided predicate evaluates true and true.
+// Returns the zero value and false if it didn't evaluate true at all.
+// Similar usage as FindIndexGeneric
+func Find[T any](slice []T, predicate func(T) bool) (T, bool) {
+	if idx := FindIndexGeneric(slice, predicate); idx != -1 {
+		return slice[idx], true
+	}
+	var zero T
+	return zero, false
+}
+
+// FindLast
+// Returns the last element for which the provided predicate evaluates true and true.
+// Returns the zero value and false if it didn't evaluate true at all.
+// Similar usage as FindLastIndexGeneric
+func FindLast[T any](slice []T, predicate func(T) bool) (T, bool) {
+	if idx := FindLastIndexGeneric(slice, predicate); idx != -1 {
+		return slice[idx], true
+	}
+	var zero T
+	return zero, false
+}
+
+// ContainsRangeGeneric
+// Returns true if the predicate evaluates true for any element with an index in [start, end-1].
+// The range is restricted to the bounds of the slice.
+// Similar usage as ContainsGeneric
+func ContainsRangeGeneric[T any](slice []T, start int, end int, predicate func(T) bool) bool {
+	start, end = clampRange(start, end, len(slice))
+	return ContainsRange(start, end, func(i int) bool {
+		return predicate(slice[i])
+	})
+}
+
+// CountRangeGeneric
+// Returns the amount of elements with an index in [start, end-1] for which the predicate did evaluate true.
+// The range is restricted to the bounds of the slice.
+// Similar usage as CountGeneric
+func CountRangeGeneric[T any](slice []T, start int, end int, predicate func(T) bool) int {
+	start, end = clampRange(start, end, len(slice))
+	return CountRange(start, end, func(i int) bool {
+		return predicate(slice[i])
+	})
+}
+
+// ContainsLastGeneric
+// Returns true if the predicate evaluates true for any of the last n elements.
+// Similar usage as ContainsGeneric
+func ContainsLastGeneric[T any](slice []T, n int, predicate func(T) bool) bool {
+	return ContainsRangeGeneric(slice, len(slice)-n, len(slice), predicate)
+}
+
+// CountLastGeneric
+// Returns the amount of the last n elements for which the predicate did evaluate true.
+// Similar usage as CountGeneric
+func CountLastGeneric[T any](slice []T, n int, predicate func(T) bool) int {
+	return CountRangeGeneric(slice, len(slice)-n, len(slice), predicate)
+}
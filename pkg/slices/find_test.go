@@ -0,0 +1,137 @@
+package slices
+
+import (
+	"github.com/rbnbr/go-utility/pkg/consts"
+	"testing"
+)
+
+// TestFindLastIndex
+// Tests FindLastIndex and FindLastIndexGeneric for found and not found cases.
+func TestFindLastIndex(t *testing.T) {
+	testSlice := []int{1, 2, 3, 4, 5, 5, 6}
+
+	gotResult := FindLastIndex(len(testSlice), func(i int) bool {
+		return testSlice[i] == 5
+	})
+	if gotResult != 5 {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, 5)
+	}
+
+	gotResult = FindLastIndexGeneric(testSlice, func(i int) bool {
+		return i == 42
+	})
+	if gotResult != -1 {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, -1)
+	}
+}
+
+// TestFindIndexFrom
+// Tests FindIndexFrom and FindIndexFromGeneric including out of bounds starts on both sides.
+func TestFindIndexFrom(t *testing.T) {
+	testSlice := []int{5, 1, 5, 2, 5}
+
+	gotResult := FindIndexFrom(1, len(testSlice), func(i int) bool {
+		return testSlice[i] == 5
+	})
+	if gotResult != 2 {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, 2)
+	}
+
+	gotResult = FindIndexFromGeneric(testSlice, 3, func(i int) bool {
+		return i == 5
+	})
+	if gotResult != 4 {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, 4)
+	}
+
+	gotResult = FindIndexFromGeneric(testSlice, 10, func(i int) bool {
+		return i == 5
+	})
+	if gotResult != -1 {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, -1)
+	}
+
+	gotResult = FindIndexFromGeneric(testSlice, -5, func(i int) bool {
+		return i == 5
+	})
+	if gotResult != -1 {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, -1)
+	}
+}
+
+// TestFindAllIndicesNth
+// Tests FindAllIndicesGeneric and FindNthGeneric.
+func TestFindAllIndicesNth(t *testing.T) {
+	testSlice := []string{"a", "b", "a", "c", "a"}
+	isA := func(s string) bool {
+		return s == "a"
+	}
+
+	expectedResult := []int{0, 2, 4}
+	gotResult := FindAllIndicesGeneric(testSlice, isA)
+	if !Equal(gotResult, expectedResult, func(a, b int) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	for n, expected := range []int{0, 2, 4, -1} {
+		gotIndex := FindNthGeneric(testSlice, n, isA)
+		if gotIndex != expected {
+			t.Errorf(consts.GotExpectedResultFmt, gotIndex, expected)
+		}
+	}
+}
+
+// TestFind
+// Tests Find and FindLast.
+func TestFind(t *testing.T) {
+	testSlice := []testEmployee{{"Amy", "A", 100}, {"Bob", "B", 200}, {"Cid", "A", 300}}
+	inA := func(e testEmployee) bool {
+		return e.Department == "A"
+	}
+
+	gotFirst, gotOk := Find(testSlice, inA)
+	if !gotOk || gotFirst.Name != "Amy" {
+		t.Errorf(consts.GotExpectedResultFmt, gotFirst, testSlice[0])
+	}
+
+	gotLast, gotOk := FindLast(testSlice, inA)
+	if !gotOk || gotLast.Name != "Cid" {
+		t.Errorf(consts.GotExpectedResultFmt, gotLast, testSlice[2])
+	}
+
+	_, gotOk = Find(testSlice, func(e testEmployee) bool {
+		return e.Department == "C"
+	})
+	if gotOk {
+		t.Errorf(consts.GotExpectedResultFmt, gotOk, false)
+	}
+}
+
+// TestContainsCountRange
+// Tests the range and last forms of ContainsGeneric and CountGeneric.
+func TestContainsCountRange(t *testing.T) {
+	testSlice := []int{1, 2, 3, 1, -1, 2, 1}
+	isOne := func(i int) bool {
+		return i == 1
+	}
+
+	if ContainsRangeGeneric(testSlice, 4, 6, isOne) || !ContainsRangeGeneric(testSlice, -3, 1, isOne) {
+		t.Errorf(consts.GotExpectedResultFmt, ContainsRangeGeneric(testSlice, 4, 6, isOne), false)
+	}
+
+	gotResult := CountRangeGeneric(testSlice, 1, 100, isOne)
+	if gotResult != 2 {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, 2)
+	}
+
+	if !ContainsLastGeneric(testSlice, 1, isOne) || ContainsLastGeneric(testSlice, 0, isOne) {
+		t.Errorf(consts.GotExpectedResultFmt, ContainsLastGeneric(testSlice, 1, isOne), true)
+	}
+
+	gotResult = CountLastGeneric(testSlice, 4, isOne)
+	if gotResult != 2 {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, 2)
+	}
+}
@@ -0,0 +1,79 @@
+package slices
+
+// The functions in this file compute the length of the result before copying any element,
+// so that the result is allocated exactly once with the required capacity.
+// ConcatSlices appends repeatedly instead and thus reallocates O(log n) times for big inputs.
+
+// Concat
+// Concatenates all provided slices into a single newly allocated slice.
+// Allocates exactly once. The result never aliases any of the provided slices.
+// Example:
+// Concat([]int{1, 2}, []int{3}, []int{4, 5}) -> [1, 2, 3, 4, 5]
+// Concat(nested...) is the preallocating equivalent of ConcatSlices(nested).
+func Concat[T any](slices ...[]T) []T {
+	n := 0
+	for _, s := range slices {
+		n += len(s)
+	}
+
+	ret := make([]T, 0, n)
+	for _, s := range slices {
+		ret = append(ret, s...)
+	}
+	return ret
+}
+
+// FlatMap
+// Maps every element of slice to a slice and concatenates the results.
+// Calls mapping exactly once per element. Besides the slices returned by mapping,
+// allocates once for the result and once for holding the intermediate slices until all of them have been returned.
+// e.g., FlatMap([1, 2, 3], x -> [x, x]) -> [1, 1, 2, 2, 3, 3]
+func FlatMap[T any, V any](slice []T, mapping func(t *T) []V) []V {
+	return Concat(Map(slice, mapping)...)
+}
+
+// Flatten2
+// Flattens two levels of nesting into a single slice.
+// Allocates exactly once.
+// e.g., [[[1, 2], [3]], [[4]]] -> [1, 2, 3, 4]
+func Flatten2[T any](slice [][][]T) []T {
+	n := 0
+	for _, outer := range slice {
+		for _, inner := range outer {
+			n += len(inner)
+		}
+	}
+
+	ret := make([]T, 0, n)
+	for _, outer := range slice {
+		for _, inner := range outer {
+			ret = append(ret, inner...)
+		}
+	}
+	return ret
+}
+
+// Flatten3
+// Flattens three levels of nesting into a single slice.
+// Allocates exactly once.
+// e.g., [[[[1], [2]]], [[[3, 4]]]] -> [1, 2, 3, 4]
+func Flatten3[T any](slice [][][][]T) []T {
+	n := 0
+	for _, outer := range slice {
+		for _, middle := range outer {
+			for _, inner := range middle {
+				n += len(inner)
+			}
+		}
+	}
+
+	ret := make([]T, 0, n)
+	for _, outer := range slice {
+		for _, middle := range outer {
+			for _, inner := range middle {
+				ret = append(ret, inner...)
+			}
+		}
+	}
+	return ret
+}
@@ -0,0 +1,116 @@
+package slices
+
+import (
+	"github.com/rbnbr/go-utility/pkg/consts"
+	"testing"
+)
+
+// TestConcat
+// Tests Concat against ConcatSlices and that the result does not alias the input.
+func TestConcat(t *testing.T) {
+	testSlice := [][]int{{1, 2, 3, 4}, {}, {1, 2, 3, 4}, {1, 2}}
+	equal := func(a, b int) bool {
+		return a == b
+	}
+
+	expectedResult := ConcatSlices(testSlice)
+	gotResult := Concat(testSlice...)
+	if !Equal(gotResult, expectedResult, equal) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+	if cap(gotResult) != len(expectedResult) {
+		t.Errorf(consts.GotExpectedResultFmt, cap(gotResult), len(expectedResult))
+	}
+
+	gotResult = Concat(testSlice[0])
+	gotResult[0] = 42
+	if testSlice[0][0] != 1 {
+		t.Errorf(consts.GotExpectedResultFmt, testSlice[0][0], 1)
+	}
+
+	gotResult = Concat[int]()
+	if gotResult == nil || len(gotResult) != 0 {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, []int{})
+	}
+}
+
+// TestFlatMap
+// Tests FlatMap with results of differing length.
+func TestFlatMap(t *testing.T) {
+	testSlice := []int{0, 1, 2, 3}
+
+	expectedResult := []int{1, 2, 2, 3, 3, 3}
+	gotResult := FlatMap(testSlice, func(i *int) []int {
+		ret := make([]int, *i)
+		for j := range ret {
+			ret[j] = *i
+		}
+		return ret
+	})
+	if !Equal(gotResult, expectedResult, func(a, b int) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+}
+
+// TestFlattenN
+// Tests Flatten2 and Flatten3.
+func TestFlattenN(t *testing.T) {
+	equal := func(a, b string) bool {
+		return a == b
+	}
+
+	expectedResult := []string{"a", "b", "c", "d"}
+	gotResult := Flatten2([][][]string{{{"a", "b"}, {}}, {}, {{"c"}, {"d"}}})
+	if !Equal(gotResult, expectedResult, equal) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	gotResult = Flatten3([][][][]string{{{{"a"}, {"b"}}}, {{{"c", "d"}}, {}}})
+	if !Equal(gotResult, expectedResult, equal) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+	if cap(gotResult) != len(expectedResult) {
+		t.Errorf(consts.GotExpectedResultFmt, cap(gotResult), len(expectedResult))
+	}
+}
+
+// benchmarkNestedSlice
+// Returns n slices of m integers each.
+func benchmarkNestedSlice(n int, m int) [][]int {
+	ret := make([][]int, n)
+	for i := range ret {
+		ret[i] = make([]int, m)
+	}
+	return ret
+}
+
+func BenchmarkConcatSlices(b *testing.B) {
+	slice := benchmarkNestedSlice(1000, 100)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ConcatSlices(slice)
+	}
+}
+
+func BenchmarkConcat(b *testing.B) {
+	slice := benchmarkNestedSlice(1000, 100)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Concat(slice...)
+	}
+}
+
+func BenchmarkFlatMap(b *testing.B) {
+	slice := benchmarkNestedSlice(1000, 100)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		FlatMap(slice, func(s *[]int) []int {
+			return *s
+		})
+	}
+}
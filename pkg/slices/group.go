@@ -0,0 +1,110 @@
+package slices
+
+import "fmt"
+
+// Number
+// Constraint for all integer and floating point types.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Group
+// A key together with all elements that have been grouped under this key.
+type Group[T comparable, V any] struct {
+	Key    T
+	Values []V
+}
+
+// GroupByOrdered
+// Same as GroupBy but returns the groups as a slice ordered by the first occurrence of their key in the provided slice.
+func GroupByOrdered[T comparable, V any](slice []V, accessor func(v V) T) []Group[T, V] {
+	ret := make([]Group[T, V], 0)
+	positions := make(map[T]int)
+
+	for i := range slice {
+		k := accessor(slice[i])
+		if idx, ok := positions[k]; ok {
+			ret[idx].Values = append(ret[idx].Values, slice[i])
+		} else {
+			positions[k] = len(ret)
+			ret = append(ret, Group[T, V]{Key: k, Values: []V{slice[i]}})
+		}
+	}
+
+	return ret
+}
+
+// GroupBy2
+// Groups the elements of a slice into two nested levels, first by the key got via accessor1, then by the key got via accessor2.
+func GroupBy2[T1 comparable, T2 comparable, V any](slice []V, accessor1 func(v V) T1, accessor2 func(v V) T2) map[T1]map[T2][]V {
+	ret := map[T1]map[T2][]V{}
+
+	for i := range slice {
+		k1 := accessor1(slice[i])
+		inner, ok := ret[k1]
+		if !ok {
+			inner = map[T2][]V{}
+			ret[k1] = inner
+		}
+		k2 := accessor2(slice[i])
+		inner[k2] = append(inner[k2], slice[i])
+	}
+
+	return ret
+}
+
+// AggregateBy
+// Groups the elements of a slice by their key got via the accessor function and reduces every group on the fly,
+// i.e., without storing the groups, using the reduce function the same way Reduce does.
+// init is the initial aggregate value of every group
+// Example:
+// maxPerDepartment := AggregateBy(employees, func(e Employee) string { return e.Department }, func(e *Employee, m *int) int { ... }, 0)
+func AggregateBy[T comparable, V any, A any](slice []V, accessor func(v V) T, reduceFunc func(newValue *V, aggregate *A) A, init A) map[T]A {
+	ret := map[T]A{}
+
+	for i := range slice {
+		k := accessor(slice[i])
+		aggregate, ok := ret[k]
+		if !ok {
+			aggregate = init
+		}
+		ret[k] = reduceFunc(&slice[i], &aggregate)
+	}
+
+	return ret
+}
+
+// CountBy
+// Returns how many elements of the slice share each key got via the accessor function.
+func CountBy[T comparable, V any](slice []V, accessor func(v V) T) map[T]int {
+	return AggregateBy(slice, accessor, func(_ *V, count *int) int {
+		return *count + 1
+	}, 0)
+}
+
+// SumBy
+// Returns the sum of the values, got via the value function, of all elements sharing each key got via the accessor function.
+func SumBy[T comparable, V any, N Number](slice []V, accessor func(v V) T, value func(v V) N) map[T]N {
+	return AggregateBy(slice, accessor, func(v *V, sum *N) N {
+		return *sum + value(*v)
+	}, 0)
+}
+
+// IndexBy
+// Returns a map from the key of every element, got via the accessor function, to the element.
+// Returns ErrDuplicateKey if two elements share the same key.
+func IndexBy[T comparable, V any](slice []V, accessor func(v V) T) (map[T]V, error) {
+	ret := make(map[T]V, len(slice))
+
+	for i := range slice {
+		k := accessor(slice[i])
+		if _, ok := ret[k]; ok {
+			return nil, fmt.Errorf("%w: '%v' at index %d", ErrDuplicateKey, k, i)
+		}
+		ret[k] = slice[i]
+	}
+
+	return ret, nil
+}
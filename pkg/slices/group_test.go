@@ -0,0 +1,97 @@
+package slices
+
+import (
+	"errors"
+	"github.com/rbnbr/go-utility/pkg/consts"
+	"testing"
+)
+
+// TestGroupByOrdered
+// Tests that the groups are ordered by the first occurrence of their key.
+func TestGroupByOrdered(t *testing.T) {
+	testSlice := []string{"banana", "apple", "blueberry", "cherry", "avocado"}
+
+	expectedResult := []Group[byte, string]{
+		{'b', []string{"banana", "blueberry"}},
+		{'a', []string{"apple", "avocado"}},
+		{'c', []string{"cherry"}},
+	}
+	gotResult := GroupByOrdered(testSlice, func(s string) byte {
+		return s[0]
+	})
+
+	if !Equal(gotResult, expectedResult, func(g1 Group[byte, string], g2 Group[byte, string]) bool {
+		return g1.Key == g2.Key && Equal(g1.Values, g2.Values, func(a, b string) bool {
+			return a == b
+		})
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+}
+
+// TestGroupBy2
+// Tests the two-level grouping.
+func TestGroupBy2(t *testing.T) {
+	testSlice := []testEmployee{{"Amy", "A", 100}, {"Bob", "A", 200}, {"Cid", "B", 100}, {"Dan", "A", 100}}
+
+	gotResult := GroupBy2(testSlice, func(e testEmployee) string {
+		return e.Department
+	}, func(e testEmployee) int {
+		return e.Salary
+	})
+
+	if len(gotResult) != 2 || len(gotResult["A"]) != 2 || len(gotResult["A"][100]) != 2 || len(gotResult["B"][100]) != 1 {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, "{A: {100: [Amy Dan], 200: [Bob]}, B: {100: [Cid]}}")
+	}
+}
+
+// TestAggregateBy
+// Tests AggregateBy, CountBy and SumBy.
+func TestAggregateBy(t *testing.T) {
+	testSlice := []testEmployee{{"Amy", "A", 100}, {"Bob", "A", 200}, {"Cid", "B", 150}}
+	department := func(e testEmployee) string {
+		return e.Department
+	}
+
+	gotMax := AggregateBy(testSlice, department, func(e *testEmployee, m *int) int {
+		if e.Salary > *m {
+			return e.Salary
+		}
+		return *m
+	}, 0)
+	if gotMax["A"] != 200 || gotMax["B"] != 150 {
+		t.Errorf(consts.GotExpectedResultFmt, gotMax, map[string]int{"A": 200, "B": 150})
+	}
+
+	gotCount := CountBy(testSlice, department)
+	if gotCount["A"] != 2 || gotCount["B"] != 1 {
+		t.Errorf(consts.GotExpectedResultFmt, gotCount, map[string]int{"A": 2, "B": 1})
+	}
+
+	gotSum := SumBy(testSlice, department, func(e testEmployee) float64 {
+		return float64(e.Salary) / 2
+	})
+	if gotSum["A"] != 150 || gotSum["B"] != 75 {
+		t.Errorf(consts.GotExpectedResultFmt, gotSum, map[string]float64{"A": 150, "B": 75})
+	}
+}
+
+// TestIndexBy
+// Tests IndexBy for unique and duplicate keys.
+func TestIndexBy(t *testing.T) {
+	testSlice := []testEmployee{{"Amy", "A", 100}, {"Bob", "A", 200}}
+
+	gotResult, gotError := IndexBy(testSlice, func(e testEmployee) string {
+		return e.Name
+	})
+	if !errors.Is(gotError, ErrNil) || gotResult["Bob"].Salary != 200 {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, testSlice)
+	}
+
+	_, gotError = IndexBy(testSlice, func(e testEmployee) string {
+		return e.Department
+	})
+	if !errors.Is(gotError, ErrDuplicateKey) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, ErrDuplicateKey)
+	}
+}
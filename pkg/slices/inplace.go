@@ -0,0 +1,95 @@
+package slices
+
+// The functions in this file modify the provided slice instead of allocating a new one.
+// Functions which remove elements move the kept elements to the front, set the vacated elements at the end
+// to the zero value so that they can be garbage collected, and return the shortened slice.
+// The provided slice must not be used afterwards except through the returned one.
+
+// zeroTail
+// Sets all elements of slice from index n on to the zero value and returns slice[:n].
+func zeroTail[T any](slice []T, n int) []T {
+	var zero T
+	for i := n; i < len(slice); i++ {
+		slice[i] = zero
+	}
+	return slice[:n]
+}
+
+// FilterInPlace
+// Same as Filter but reuses the backing array of the provided slice.
+func FilterInPlace[T any](slice []T, predicate func(T) bool) []T {
+	n := 0
+	for i := range slice {
+		if predicate(slice[i]) {
+			slice[n] = slice[i]
+			n++
+		}
+	}
+	return zeroTail(slice, n)
+}
+
+// UniqueInPlace
+// Same as UniqueFirst but reuses the backing array of the provided slice.
+// Has quadratic runtime, see UniqueByInPlace for a linear alternative.
+func UniqueInPlace[T any](slice []T, predicate func(T, T) bool) []T {
+	n := 0
+	for i := range slice {
+		if !ContainsGeneric(slice[:n], func(u T) bool {
+			return predicate(slice[i], u)
+		}) {
+			slice[n] = slice[i]
+			n++
+		}
+	}
+	return zeroTail(slice, n)
+}
+
+// UniqueByInPlace
+// Same as UniqueBy but reuses the backing array of the provided slice.
+func UniqueByInPlace[T any, K comparable](slice []T, accessor func(T) K) []T {
+	seen := make(map[K]struct{})
+	n := 0
+	for i := range slice {
+		k := accessor(slice[i])
+		if _, ok := seen[k]; !ok {
+			seen[k] = struct{}{}
+			slice[n] = slice[i]
+			n++
+		}
+	}
+	return zeroTail(slice, n)
+}
+
+// CompactInPlace
+// Replaces every run of consecutive equal elements by its first element, using the predicate to compare equality.
+// Unlike UniqueInPlace, equal elements which are not adjacent are kept, which allows for linear runtime.
+func CompactInPlace[T any](slice []T, predicate func(T, T) bool) []T {
+	if len(slice) == 0 {
+		return slice
+	}
+
+	n := 1
+	for i := 1; i < len(slice); i++ {
+		if !predicate(slice[n-1], slice[i]) {
+			slice[n] = slice[i]
+			n++
+		}
+	}
+	return zeroTail(slice, n)
+}
+
+// ReverseInPlace
+// Reverses the order of the elements of the provided slice.
+func ReverseInPlace[T any](slice []T) {
+	for i, j := 0, len(slice)-1; i < j; i, j = i+1, j-1 {
+		slice[i], slice[j] = slice[j], slice[i]
+	}
+}
+
+// MapInPlace
+// Same as Map but stores the results in the provided slice, thus the mapping function has to return the same type.
+func MapInPlace[T any](slice []T, mapping func(t *T) T) {
+	for i := range slice {
+		slice[i] = mapping(&slice[i])
+	}
+}
@@ -0,0 +1,163 @@
+package slices
+
+import (
+	"github.com/rbnbr/go-utility/pkg/consts"
+	"testing"
+)
+
+// TestFilterInPlace
+// Tests that FilterInPlace keeps the backing array and zeroes the vacated elements.
+func TestFilterInPlace(t *testing.T) {
+	one, two, three := 1, 2, 3
+	testSlice := []*int{&one, &two, &three}
+
+	gotResult := FilterInPlace(testSlice, func(i *int) bool {
+		return *i != 2
+	})
+
+	if len(gotResult) != 2 || *gotResult[0] != 1 || *gotResult[1] != 3 {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, []int{1, 3})
+	}
+	if &gotResult[0] != &testSlice[0] {
+		t.Errorf(consts.GotExpectedResultFmt, "new backing array", "same backing array")
+	}
+	if testSlice[2] != nil {
+		t.Errorf(consts.GotExpectedResultFmt, testSlice[2], nil)
+	}
+}
+
+// TestUniqueInPlace
+// Tests UniqueInPlace and UniqueByInPlace against UniqueFirst.
+func TestUniqueInPlace(t *testing.T) {
+	testPredicate := func(a, b float64) bool {
+		return int(a) == int(b)
+	}
+	equal := func(a, b float64) bool {
+		return a == b
+	}
+
+	expectedResult := UniqueFirst([]float64{1.0, 2.0, 4.0, -1.0, 1.1, 2.1, 0.0, 1.2}, testPredicate)
+
+	gotResult := UniqueInPlace([]float64{1.0, 2.0, 4.0, -1.0, 1.1, 2.1, 0.0, 1.2}, testPredicate)
+	if !Equal(gotResult, expectedResult, equal) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	gotResult = UniqueByInPlace([]float64{1.0, 2.0, 4.0, -1.0, 1.1, 2.1, 0.0, 1.2}, func(a float64) int {
+		return int(a)
+	})
+	if !Equal(gotResult, expectedResult, equal) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+}
+
+// TestCompactInPlace
+// Tests that only consecutive duplicates are removed.
+func TestCompactInPlace(t *testing.T) {
+	testSlice := []int{1, 1, 2, 2, 2, 1, 3, 3}
+
+	expectedResult := []int{1, 2, 1, 3}
+	gotResult := CompactInPlace(testSlice, func(a, b int) bool {
+		return a == b
+	})
+	if !Equal(gotResult, expectedResult, func(a, b int) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	if testSlice[4] != 0 || testSlice[7] != 0 {
+		t.Errorf(consts.GotExpectedResultFmt, testSlice, []int{1, 2, 1, 3, 0, 0, 0, 0})
+	}
+}
+
+// TestReverseMapInPlace
+// Tests ReverseInPlace and MapInPlace.
+func TestReverseMapInPlace(t *testing.T) {
+	testSlice := []int{1, 2, 3, 4, 5}
+
+	expectedResult := []int{10, 8, 6, 4, 2}
+	ReverseInPlace(testSlice)
+	MapInPlace(testSlice, func(i *int) int {
+		return (*i) * 2
+	})
+	if !Equal(testSlice, expectedResult, func(a, b int) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, testSlice, expectedResult)
+	}
+}
+
+func BenchmarkFilter(b *testing.B) {
+	slice := benchmarkUniqueSlice(10000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Filter(slice, func(v int) bool {
+			return v%2 == 0
+		})
+	}
+}
+
+func BenchmarkFilterInPlace(b *testing.B) {
+	slice := benchmarkUniqueSlice(10000)
+	work := make([]int, len(slice))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		work = work[:len(slice)]
+		copy(work, slice)
+		FilterInPlace(work, func(v int) bool {
+			return v%2 == 0
+		})
+	}
+}
+
+func BenchmarkUniqueByCopy(b *testing.B) {
+	slice := benchmarkUniqueSlice(10000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		UniqueBy(slice, func(v int) int {
+			return v
+		})
+	}
+}
+
+func BenchmarkUniqueByInPlace(b *testing.B) {
+	slice := benchmarkUniqueSlice(10000)
+	work := make([]int, len(slice))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		work = work[:len(slice)]
+		copy(work, slice)
+		UniqueByInPlace(work, func(v int) int {
+			return v
+		})
+	}
+}
+
+func BenchmarkMap(b *testing.B) {
+	slice := benchmarkUniqueSlice(10000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Map(slice, func(v *int) int {
+			return *v + 1
+		})
+	}
+}
+
+func BenchmarkMapInPlace(b *testing.B) {
+	slice := benchmarkUniqueSlice(10000)
+	work := make([]int, len(slice))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(work, slice)
+		MapInPlace(work, func(v *int) int {
+			return *v + 1
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package slices
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// parallelFor
+// Calls body for every index in [0, n-1] using up to workers goroutines.
+// The context passed to body is cancelled as soon as the first error occurred.
+// If workers is smaller than 1, runtime.GOMAXPROCS(0) is used instead.
+// Stops handing out new indices as soon as body returns an error or ctx is done and returns the first error that occurred.
+func parallelFor(ctx context.Context, n int, workers int, body func(ctx context.Context, i int) error) error {
+	if n == 0 {
+		return ctx.Err()
+	}
+
+	workers = normalizeWorkers(workers, n)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		next     int64 = -1
+		once     sync.Once
+		firstErr error
+		wg       sync.WaitGroup
+	)
+
+	fail := func(err error) {
+		once.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for {
+				if err := ctx.Err(); err != nil {
+					fail(err)
+					return
+				}
+
+				i := int(atomic.AddInt64(&next, 1))
+				if i >= n {
+					return
+				}
+
+				if err := body(ctx, i); err != nil {
+					fail(err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	return firstErr
+}
+
+// normalizeWorkers
+// Returns the amount of goroutines to use for n elements given the requested amount of workers.
+func normalizeWorkers(workers int, n int) int {
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > n {
+		workers = n
+	}
+	return workers
+}
+
+// ParallelMap
+// Same as Map but applies the mapping function concurrently using up to workers goroutines.
+// If workers is smaller than 1, runtime.GOMAXPROCS(0) is used instead.
+// The order of the returned slice matches the order of the provided slice.
+// Stops early if ctx is done or the mapping function returns an error and returns the first error that occurred.
+func ParallelMap[T any, V any](ctx context.Context, slice []T, mapping func(t *T) (V, error), workers int) ([]V, error) {
+	ret := make([]V, len(slice))
+
+	if err := parallelFor(ctx, len(slice), workers, func(_ context.Context, i int) error {
+		v, err := mapping(&slice[i])
+		if err != nil {
+			return err
+		}
+		ret[i] = v
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+// ParallelMapI
+// Same as ParallelMap but also passes an integer to the mapping function representing the current index.
+func ParallelMapI[T any, V any](ctx context.Context, slice []T, mapping func(t *T, i int) (V, error), workers int) ([]V, error) {
+	ret := make([]V, len(slice))
+
+	if err := parallelFor(ctx, len(slice), workers, func(_ context.Context, i int) error {
+		v, err := mapping(&slice[i], i)
+		if err != nil {
+			return err
+		}
+		ret[i] = v
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+// ParallelFilter
+// Same as Filter but evaluates the predicate concurrently using up to workers goroutines.
+// If workers is smaller than 1, runtime.GOMAXPROCS(0) is used instead.
+// The kept elements are returned in the same order as in the provided slice.
+// Stops early if ctx is done or the predicate returns an error and returns the first error that occurred.
+func ParallelFilter[T any](ctx context.Context, slice []T, predicate func(T) (bool, error), workers int) ([]T, error) {
+	keep := make([]bool, len(slice))
+
+	if err := parallelFor(ctx, len(slice), workers, func(_ context.Context, i int) error {
+		ok, err := predicate(slice[i])
+		if err != nil {
+			return err
+		}
+		keep[i] = ok
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	ret := make([]T, 0)
+	for i := range slice {
+		if keep[i] {
+			ret = append(ret, slice[i])
+		}
+	}
+
+	return ret, nil
+}
+
+// ParallelReduce
+// Same as Reduce but splits the provided slice into up to workers contiguous parts which are reduced concurrently.
+// If workers is smaller than 1, runtime.GOMAXPROCS(0) is used instead.
+// Every part starts its aggregate with init, thus init has to be the neutral element of combine.
+// The partial aggregates are then merged from left to right using the combine function, which has to be associative.
+// Stops early if ctx is done or the reduce function returns an error and returns the first error that occurred.
+// Example:
+// sum, err := ParallelReduce(ctx, someInts, func(n *int, a *int) (int, error) { return *a + *n, nil }, func(l *int, r *int) int { return *l + *r }, 0, 4)
+func ParallelReduce[T any, V any](ctx context.Context, slice []T, reduceFunc func(newValue *T, aggregate *V) (V, error), combine func(left *V, right *V) V, init V, workers int) (V, error) {
+	n := len(slice)
+	if n == 0 {
+		return init, ctx.Err()
+	}
+
+	parts := normalizeWorkers(workers, n)
+	partSize := (n + parts - 1) / parts
+	parts = (n + partSize - 1) / partSize
+
+	partials := make([]V, parts)
+
+	if err := parallelFor(ctx, parts, parts, func(ctx context.Context, p int) error {
+		start := p * partSize
+		end := start + partSize
+		if end > n {
+			end = n
+		}
+
+		aggregate := init
+		for i := start; i < end; i++ {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			v, err := reduceFunc(&slice[i], &aggregate)
+			if err != nil {
+				return err
+			}
+			aggregate = v
+		}
+		partials[p] = aggregate
+		return nil
+	}); err != nil {
+		var zero V
+		return zero, err
+	}
+
+	ret := partials[0]
+	for p := 1; p < parts; p++ {
+		ret = combine(&ret, &partials[p])
+	}
+
+	return ret, nil
+}
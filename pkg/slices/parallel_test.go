@@ -0,0 +1,147 @@
+package slices
+
+import (
+	"context"
+	"errors"
+	"github.com/rbnbr/go-utility/pkg/consts"
+	"testing"
+)
+
+// TestParallelMap
+// Tests that ParallelMap keeps the order of the input for several worker counts.
+func TestParallelMap(t *testing.T) {
+	testSlice := make([]int, 1000)
+	for i := range testSlice {
+		testSlice[i] = i
+	}
+
+	expectedResult := Map(testSlice, func(i *int) int {
+		return (*i) * 2
+	})
+
+	for _, workers := range []int{0, 1, 3, 2000} {
+		gotResult, gotError := ParallelMap(context.Background(), testSlice, func(i *int) (int, error) {
+			return (*i) * 2, nil
+		}, workers)
+		if !errors.Is(gotError, ErrNil) {
+			t.Errorf(consts.GotExpectedErrorFmt, gotError, ErrNil)
+		}
+
+		if !Equal(gotResult, expectedResult, func(a int, b int) bool {
+			return a == b
+		}) {
+			t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+		}
+	}
+}
+
+// TestParallelMap_error
+// Tests that ParallelMap surfaces the error returned by the mapping function and that a cancelled context is respected.
+func TestParallelMap_error(t *testing.T) {
+	testSlice := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	testError := errors.New("test error")
+
+	_, gotError := ParallelMap(context.Background(), testSlice, func(i *int) (int, error) {
+		if *i == 5 {
+			return 0, testError
+		}
+		return *i, nil
+	}, 4)
+	if !errors.Is(gotError, testError) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, testError)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, gotError = ParallelMap(ctx, testSlice, func(i *int) (int, error) {
+		return *i, nil
+	}, 4)
+	if !errors.Is(gotError, context.Canceled) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, context.Canceled)
+	}
+}
+
+// TestParallelMapI
+// Tests the ParallelMapI function.
+func TestParallelMapI(t *testing.T) {
+	testSlice := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+
+	expectedResult := []int{0, 3, 6, 9, 12, 15, 18, 21, 24, 27}
+	gotResult, gotError := ParallelMapI(context.Background(), testSlice, func(i *int, idx int) (int, error) {
+		return (*i)*2 + idx, nil
+	}, 3)
+	if !errors.Is(gotError, ErrNil) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, ErrNil)
+	}
+
+	if !Equal(gotResult, expectedResult, func(a int, b int) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+}
+
+// TestParallelFilter
+// Tests that ParallelFilter returns the same result as Filter.
+func TestParallelFilter(t *testing.T) {
+	testSlice := make([]int, 500)
+	for i := range testSlice {
+		testSlice[i] = i
+	}
+
+	expectedResult := Filter(testSlice, func(i int) bool { return i%3 == 0 })
+	gotResult, gotError := ParallelFilter(context.Background(), testSlice, func(i int) (bool, error) {
+		return i%3 == 0, nil
+	}, 4)
+	if !errors.Is(gotError, ErrNil) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, ErrNil)
+	}
+
+	if !Equal(gotResult, expectedResult, func(a int, b int) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+}
+
+// TestParallelReduce
+// Tests ParallelReduce with a sum and with an order sensitive concatenation.
+func TestParallelReduce(t *testing.T) {
+	testSlice := make([]int, 1001)
+	for i := range testSlice {
+		testSlice[i] = i
+	}
+
+	expectedResult := 500500
+	gotResult, gotError := ParallelReduce(context.Background(), testSlice, func(i *int, v *int) (int, error) {
+		return (*i) + (*v), nil
+	}, func(l *int, r *int) int {
+		return (*l) + (*r)
+	}, 0, 7)
+	if !errors.Is(gotError, ErrNil) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, ErrNil)
+	}
+
+	if gotResult != expectedResult {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	testSliceConcat := [][]int{{0, 1}, {2, 3}, {4, 5, 6}, {7, 8, 9, 10}}
+
+	expectedResultConcat := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	gotResultConcat, gotError := ParallelReduce(context.Background(), testSliceConcat, func(i *[]int, v *[]int) ([]int, error) {
+		return append(*v, *i...), nil
+	}, func(l *[]int, r *[]int) []int {
+		return append(*l, *r...)
+	}, nil, 3)
+	if !errors.Is(gotError, ErrNil) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, ErrNil)
+	}
+
+	if !Equal(gotResultConcat, expectedResultConcat, func(i int, i2 int) bool {
+		return i == i2
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResultConcat, expectedResultConcat)
+	}
+}
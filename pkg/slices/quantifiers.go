@@ -0,0 +1,223 @@
+package slices
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+)
+
+// AnyIndex
+// Returns true if the predicate evaluates true for any index in [0, limit-1].
+// Stops at the first index for which the predicate evaluates true.
+// Similar usage as FindIndex
+func AnyIndex(limit int, predicate func(int) bool) bool {
+	return FindIndex(limit, predicate) != -1
+}
+
+// AllIndex
+// Returns true if the predicate evaluates true for all indices in [0, limit-1].
+// Stops at the first index for which the predicate evaluates false. Returns true for limit 0.
+// Similar usage as FindIndex
+func AllIndex(limit int, predicate func(int) bool) bool {
+	return FindIndex(limit, func(i int) bool {
+		return !predicate(i)
+	}) == -1
+}
+
+// NoneIndex
+// Returns true if the predicate evaluates true for none of the indices in [0, limit-1].
+// Stops at the first index for which the predicate evaluates true.
+// Similar usage as FindIndex
+func NoneIndex(limit int, predicate func(int) bool) bool {
+	return !AnyIndex(limit, predicate)
+}
+
+// ExactlyNIndex
+// Returns true if the predicate evaluates true for exactly n indices in [0, limit-1].
+// Stops as soon as the predicate evaluated true more than n times.
+// Similar usage as FindIndex
+func ExactlyNIndex(limit int, n int, predicate func(int) bool) bool {
+	if n < 0 {
+		return false
+	}
+	count := 0
+	for i := 0; i < limit; i++ {
+		if predicate(i) {
+			count++
+			if count > n {
+				return false
+			}
+		}
+	}
+	return count == n
+}
+
+// AnyGeneric
+// Returns true if the predicate evaluates true for any element of slice.
+// Unlike Any, does not require mapping the slice to a []bool first and stops at the first match.
+// Similar usage as FindIndexGeneric
+func AnyGeneric[T any](slice []T, predicate func(T) bool) bool {
+	return AnyIndex(len(slice), func(i int) bool {
+		return predicate(slice[i])
+	})
+}
+
+// AllGeneric
+// Returns true if the predicate evaluates true for all elements of slice.
+// Stops at the first element for which the predicate evaluates false. Returns true for an empty slice.
+// Similar usage as FindIndexGeneric
+func AllGeneric[T any](slice []T, predicate func(T) bool) bool {
+	return AllIndex(len(slice), func(i int) bool {
+		return predicate(slice[i])
+	})
+}
+
+// NoneGeneric
+// Returns true if the predicate evaluates true for none of the elements of slice.
+// Similar usage as FindIndexGeneric
+func NoneGeneric[T any](slice []T, predicate func(T) bool) bool {
+	return !AnyGeneric(slice, predicate)
+}
+
+// ExactlyN
+// Returns true if the predicate evaluates true for exactly n elements of slice.
+// Stops as soon as the predicate evaluated true more than n times.
+// Similar usage as FindIndexGeneric
+func ExactlyN[T any](slice []T, n int, predicate func(T) bool) bool {
+	return ExactlyNIndex(len(slice), n, func(i int) bool {
+		return predicate(slice[i])
+	})
+}
+
+// AnyValue
+// Returns true if the predicate evaluates true for any value of the provided map.
+// Stops at the first match.
+func AnyValue[K comparable, V any](m map[K]V, predicate func(V) bool) bool {
+	for _, v := range m {
+		if predicate(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllValues
+// Returns true if the predicate evaluates true for all values of the provided map.
+// Stops at the first value for which the predicate evaluates false. Returns true for an empty map.
+func AllValues[K comparable, V any](m map[K]V, predicate func(V) bool) bool {
+	for _, v := range m {
+		if !predicate(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// NoneValues
+// Returns true if the predicate evaluates true for none of the values of the provided map.
+func NoneValues[K comparable, V any](m map[K]V, predicate func(V) bool) bool {
+	return !AnyValue(m, predicate)
+}
+
+// ExactlyNValues
+// Returns true if the predicate evaluates true for exactly n values of the provided map.
+// Stops as soon as the predicate evaluated true more than n times.
+func ExactlyNValues[K comparable, V any](m map[K]V, n int, predicate func(V) bool) bool {
+	if n < 0 {
+		return false
+	}
+	count := 0
+	for _, v := range m {
+		if predicate(v) {
+			count++
+			if count > n {
+				return false
+			}
+		}
+	}
+	return count == n
+}
+
+// errShortCircuit
+// Used internally to cancel the remaining workers of parallelFor once the result is known.
+var errShortCircuit = errors.New("short circuit")
+
+// parallelShortCircuit
+// Calls body for every index in [0, n-1] using up to workers goroutines until body returns true for any index.
+// Returns whether body returned true for any index, or the first error that occurred.
+func parallelShortCircuit(ctx context.Context, n int, workers int, body func(i int) (bool, error)) (bool, error) {
+	err := parallelFor(ctx, n, workers, func(_ context.Context, i int) error {
+		stop, err := body(i)
+		if err != nil {
+			return err
+		}
+		if stop {
+			return errShortCircuit
+		}
+		return nil
+	})
+	if errors.Is(err, errShortCircuit) {
+		return true, nil
+	}
+	return false, err
+}
+
+// ParallelAny
+// Same as AnyGeneric but evaluates the predicate concurrently using up to workers goroutines.
+// If workers is smaller than 1, runtime.GOMAXPROCS(0) is used instead.
+// Stops handing out new elements as soon as the predicate evaluated true for any element.
+// Stops early if ctx is done or the predicate returns an error and returns the first error that occurred.
+func ParallelAny[T any](ctx context.Context, slice []T, predicate func(T) (bool, error), workers int) (bool, error) {
+	return parallelShortCircuit(ctx, len(slice), workers, func(i int) (bool, error) {
+		return predicate(slice[i])
+	})
+}
+
+// ParallelAll
+// Same as AllGeneric but evaluates the predicate concurrently using up to workers goroutines.
+// Stops handing out new elements as soon as the predicate evaluated false for any element.
+// See ParallelAny for the handling of workers, ctx and errors.
+func ParallelAll[T any](ctx context.Context, slice []T, predicate func(T) (bool, error), workers int) (bool, error) {
+	found, err := parallelShortCircuit(ctx, len(slice), workers, func(i int) (bool, error) {
+		ok, err := predicate(slice[i])
+		return !ok, err
+	})
+	if err != nil {
+		return false, err
+	}
+	return !found, nil
+}
+
+// ParallelNone
+// Same as NoneGeneric but evaluates the predicate concurrently using up to workers goroutines.
+// See ParallelAny for the handling of workers, ctx and errors.
+func ParallelNone[T any](ctx context.Context, slice []T, predicate func(T) (bool, error), workers int) (bool, error) {
+	found, err := ParallelAny(ctx, slice, predicate, workers)
+	if err != nil {
+		return false, err
+	}
+	return !found, nil
+}
+
+// ParallelExactlyN
+// Same as ExactlyN but evaluates the predicate concurrently using up to workers goroutines.
+// Stops handing out new elements as soon as the predicate evaluated true more than n times.
+// See ParallelAny for the handling of workers, ctx and errors.
+func ParallelExactlyN[T any](ctx context.Context, slice []T, n int, predicate func(T) (bool, error), workers int) (bool, error) {
+	if n < 0 {
+		return false, nil
+	}
+
+	var count int64
+	exceeded, err := parallelShortCircuit(ctx, len(slice), workers, func(i int) (bool, error) {
+		ok, err := predicate(slice[i])
+		if err != nil || !ok {
+			return false, err
+		}
+		return atomic.AddInt64(&count, 1) > int64(n), nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return !exceeded && atomic.LoadInt64(&count) == int64(n), nil
+}
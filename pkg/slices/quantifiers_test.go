@@ -0,0 +1,129 @@
+package slices
+
+import (
+	"context"
+	"errors"
+	"github.com/rbnbr/go-utility/pkg/consts"
+	"sync/atomic"
+	"testing"
+)
+
+// TestQuantifiers
+// Tests AnyGeneric, AllGeneric, NoneGeneric and ExactlyN including the empty slice and short-circuiting.
+func TestQuantifiers(t *testing.T) {
+	testSlice := []int{2, 4, 5, 6, 8}
+	calls := 0
+	isOdd := func(i int) bool {
+		calls++
+		return i%2 == 1
+	}
+
+	if !AnyGeneric(testSlice, isOdd) || calls != 3 {
+		t.Errorf(consts.GotExpectedResultFmt, calls, 3)
+	}
+
+	calls = 0
+	if AllGeneric(testSlice, func(i int) bool {
+		return !isOdd(i)
+	}) || calls != 3 {
+		t.Errorf(consts.GotExpectedResultFmt, calls, 3)
+	}
+
+	if NoneGeneric(testSlice, isOdd) || !NoneGeneric([]int{}, isOdd) || !AllGeneric([]int{}, isOdd) {
+		t.Errorf(consts.GotExpectedResultFmt, NoneGeneric(testSlice, isOdd), false)
+	}
+
+	for n, expected := range []bool{false, true, false} {
+		gotResult := ExactlyN(testSlice, n, isOdd)
+		if gotResult != expected {
+			t.Errorf(consts.GotExpectedResultFmt, gotResult, expected)
+		}
+	}
+
+	gotResult := ExactlyNIndex(len(testSlice), 4, func(i int) bool {
+		return testSlice[i] != 5
+	})
+	if !gotResult {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, true)
+	}
+}
+
+// TestQuantifiersValues
+// Tests the quantifiers over the values of a map.
+func TestQuantifiersValues(t *testing.T) {
+	testMap := map[string]int{"a": 1, "b": 2, "c": 3}
+	isPositive := func(i int) bool {
+		return i > 0
+	}
+	isEven := func(i int) bool {
+		return i%2 == 0
+	}
+
+	if !AllValues(testMap, isPositive) || !AnyValue(testMap, isEven) || NoneValues(testMap, isEven) {
+		t.Errorf(consts.GotExpectedResultFmt, AllValues(testMap, isPositive), true)
+	}
+	if !ExactlyNValues(testMap, 1, isEven) || ExactlyNValues(testMap, 2, isEven) {
+		t.Errorf(consts.GotExpectedResultFmt, ExactlyNValues(testMap, 1, isEven), true)
+	}
+}
+
+// TestParallelQuantifiers
+// Tests the parallel quantifiers against their sequential counterparts, including the error case.
+func TestParallelQuantifiers(t *testing.T) {
+	testSlice := make([]int, 1000)
+	for i := range testSlice {
+		testSlice[i] = i
+	}
+	isBig := func(i int) (bool, error) {
+		return i >= 990, nil
+	}
+
+	gotResult, _ := ParallelAny(context.Background(), testSlice, isBig, 4)
+	if !gotResult {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, true)
+	}
+
+	gotResult, _ = ParallelAll(context.Background(), testSlice, isBig, 4)
+	if gotResult {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, false)
+	}
+
+	gotResult, _ = ParallelNone(context.Background(), testSlice, func(i int) (bool, error) {
+		return i < 0, nil
+	}, 4)
+	if !gotResult {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, true)
+	}
+
+	for n, expected := range map[int]bool{9: false, 10: true, 11: false} {
+		gotResult, _ = ParallelExactlyN(context.Background(), testSlice, n, isBig, 4)
+		if gotResult != expected {
+			t.Errorf(consts.GotExpectedResultFmt, gotResult, expected)
+		}
+	}
+
+	_, gotError := ParallelAll(context.Background(), testSlice, func(i int) (bool, error) {
+		if i == 500 {
+			return false, ErrInvalidArgument
+		}
+		return true, nil
+	}, 4)
+	if !errors.Is(gotError, ErrInvalidArgument) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, ErrInvalidArgument)
+	}
+}
+
+// TestParallelAny_shortCircuit
+// Tests that ParallelAny stops handing out elements once a match was found.
+func TestParallelAny_shortCircuit(t *testing.T) {
+	testSlice := make([]int, 100000)
+	var calls int64
+
+	gotResult, _ := ParallelAny(context.Background(), testSlice, func(i int) (bool, error) {
+		atomic.AddInt64(&calls, 1)
+		return true, nil
+	}, 4)
+	if !gotResult || atomic.LoadInt64(&calls) == int64(len(testSlice)) {
+		t.Errorf(consts.GotExpectedResultFmt, atomic.LoadInt64(&calls), "less than all elements")
+	}
+}
@@ -0,0 +1,267 @@
+package slices
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// All functions in this file take a random source as argument so that results are reproducible,
+// e.g., by passing rand.New(rand.NewSource(42)).
+// If the provided source is nil, the global source of math/rand is used instead.
+// A *rand.Rand must not be used by multiple goroutines concurrently.
+
+// randIntn
+// Returns a random int in [0, n-1] from rng or from the global source if rng is nil.
+func randIntn(rng *rand.Rand, n int) int {
+	if rng == nil {
+		return rand.Intn(n)
+	}
+	return rng.Intn(n)
+}
+
+// randFloat64
+// Returns a random float64 in [0, 1) from rng or from the global source if rng is nil.
+func randFloat64(rng *rand.Rand) float64 {
+	if rng == nil {
+		return rand.Float64()
+	}
+	return rng.Float64()
+}
+
+// ShuffleInPlace
+// Shuffles the elements of slice uniformly at random using the Fisher-Yates algorithm.
+func ShuffleInPlace[T any](slice []T, rng *rand.Rand) {
+	for i := len(slice) - 1; i > 0; i-- {
+		j := randIntn(rng, i+1)
+		slice[i], slice[j] = slice[j], slice[i]
+	}
+}
+
+// Shuffle
+// Returns a shuffled copy of slice, the provided slice remains unchanged.
+// See ShuffleInPlace
+func Shuffle[T any](slice []T, rng *rand.Rand) []T {
+	ret := make([]T, len(slice))
+	copy(ret, slice)
+	ShuffleInPlace(ret, rng)
+	return ret
+}
+
+// Sample
+// Returns k elements of slice chosen uniformly at random without replacement, in random order.
+// Every element is chosen at most once, i.e., equal values are only returned multiple times if they occur multiple times.
+// Returns ErrInvalidArgument if k is negative or greater than the length of slice.
+func Sample[T any](slice []T, k int, rng *rand.Rand) ([]T, error) {
+	if k < 0 || k > len(slice) {
+		return nil, fmt.Errorf("%w: sample size %d not in [0, %d]", ErrInvalidArgument, k, len(slice))
+	}
+
+	// partial Fisher-Yates shuffle on the positions, only swapped positions are stored
+	swapped := make(map[int]int)
+	at := func(i int) int {
+		if v, ok := swapped[i]; ok {
+			return v
+		}
+		return i
+	}
+
+	ret := make([]T, k)
+	for i := 0; i < k; i++ {
+		j := i + randIntn(rng, len(slice)-i)
+		vi, vj := at(i), at(j)
+		swapped[i], swapped[j] = vj, vi
+		ret[i] = slice[vj]
+	}
+	return ret, nil
+}
+
+// SampleWithReplacement
+// Returns k elements of slice chosen uniformly at random with replacement.
+// Returns ErrInvalidArgument if k is negative or if k is positive and slice is empty.
+func SampleWithReplacement[T any](slice []T, k int, rng *rand.Rand) ([]T, error) {
+	if k < 0 {
+		return nil, fmt.Errorf("%w: sample size has to be non-negative but is %d", ErrInvalidArgument, k)
+	}
+	if k > 0 && len(slice) == 0 {
+		return nil, fmt.Errorf("%w: cannot sample from an empty slice", ErrInvalidArgument)
+	}
+
+	ret := make([]T, k)
+	for i := range ret {
+		ret[i] = slice[randIntn(rng, len(slice))]
+	}
+	return ret, nil
+}
+
+// AliasTable
+// A discrete probability distribution over the indices [0, n-1] which allows drawing in constant time.
+// Building the table takes linear time, thus it should be reused for repeated draws from the same distribution.
+// See Vose's alias method.
+type AliasTable struct {
+	probability []float64
+	alias       []int
+}
+
+// NewAliasTable
+// Returns an alias table where index i is drawn with probability weights[i] / sum(weights).
+// Returns ErrInvalidArgument if weights is empty, contains a negative, NaN or infinite weight or sums up to 0.
+func NewAliasTable(weights []float64) (*AliasTable, error) {
+	n := len(weights)
+	if n == 0 {
+		return nil, fmt.Errorf("%w: weights must not be empty", ErrInvalidArgument)
+	}
+
+	sum := 0.0
+	for i, w := range weights {
+		if w < 0 || math.IsNaN(w) || math.IsInf(w, 0) {
+			return nil, fmt.Errorf("%w: invalid weight %v at index %d", ErrInvalidArgument, w, i)
+		}
+		sum += w
+	}
+	if sum == 0 || math.IsInf(sum, 0) {
+		return nil, fmt.Errorf("%w: weights have to sum up to a positive finite value but sum up to %v", ErrInvalidArgument, sum)
+	}
+
+	table := &AliasTable{
+		probability: make([]float64, n),
+		alias:       make([]int, n),
+	}
+
+	scaled := make([]float64, n)
+	small := make([]int, 0, n)
+	large := make([]int, 0, n)
+	for i, w := range weights {
+		scaled[i] = w * float64(n) / sum
+		if scaled[i] < 1 {
+			small = append(small, i)
+		} else {
+			large = append(large, i)
+		}
+	}
+
+	for len(small) > 0 && len(large) > 0 {
+		s, l := small[len(small)-1], large[len(large)-1]
+		small = small[:len(small)-1]
+
+		table.probability[s] = scaled[s]
+		table.alias[s] = l
+
+		scaled[l] = scaled[l] + scaled[s] - 1
+		if scaled[l] < 1 {
+			large = large[:len(large)-1]
+			small = append(small, l)
+		}
+	}
+
+	// the remaining entries are 1 up to rounding errors
+	for _, i := range append(small, large...) {
+		table.probability[i] = 1
+		table.alias[i] = i
+	}
+
+	return table, nil
+}
+
+// Len
+// Returns the amount of indices of the distribution.
+func (a *AliasTable) Len() int {
+	return len(a.probability)
+}
+
+// Draw
+// Returns a random index according to the distribution of the table.
+func (a *AliasTable) Draw(rng *rand.Rand) int {
+	i := randIntn(rng, len(a.probability))
+	if randFloat64(rng) < a.probability[i] {
+		return i
+	}
+	return a.alias[i]
+}
+
+// WeightedSample
+// Returns k elements of slice chosen at random with replacement, where slice[i] is chosen with probability
+// weights[i] / sum(weights).
+// Builds an AliasTable internally, use NewAliasTable directly to draw repeatedly from the same distribution.
+// Returns ErrInvalidArgument if the lengths of slice and weights differ, k is negative or see NewAliasTable.
+func WeightedSample[T any](slice []T, weights []float64, k int, rng *rand.Rand) ([]T, error) {
+	if len(slice) != len(weights) {
+		return nil, fmt.Errorf("%w: got %d elements but %d weights", ErrInvalidArgument, len(slice), len(weights))
+	}
+	if k < 0 {
+		return nil, fmt.Errorf("%w: sample size has to be non-negative but is %d", ErrInvalidArgument, k)
+	}
+
+	table, err := NewAliasTable(weights)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]T, k)
+	for i := range ret {
+		ret[i] = slice[table.Draw(rng)]
+	}
+	return ret, nil
+}
+
+// Reservoir
+// Keeps a uniform random sample of up to k elements of a sequence of unknown length
+// using constant memory, see reservoir sampling (Algorithm R).
+type Reservoir[T any] struct {
+	sample []T
+	k      int
+	seen   int
+	rng    *rand.Rand
+}
+
+// NewReservoir
+// Returns an empty reservoir which keeps up to k elements.
+// Returns ErrInvalidArgument if k is negative.
+func NewReservoir[T any](k int, rng *rand.Rand) (*Reservoir[T], error) {
+	if k < 0 {
+		return nil, fmt.Errorf("%w: reservoir size has to be non-negative but is %d", ErrInvalidArgument, k)
+	}
+	return &Reservoir[T]{sample: make([]T, 0, k), k: k, rng: rng}, nil
+}
+
+// Add
+// Offers the next element of the sequence to the reservoir.
+func (r *Reservoir[T]) Add(value T) {
+	r.seen++
+	if len(r.sample) < r.k {
+		r.sample = append(r.sample, value)
+		return
+	}
+	if j := randIntn(r.rng, r.seen); j < r.k {
+		r.sample[j] = value
+	}
+}
+
+// Seen
+// Returns the amount of elements added to the reservoir so far.
+func (r *Reservoir[T]) Seen() int {
+	return r.seen
+}
+
+// Sample
+// Returns a copy of the current sample, which contains min(k, Seen()) elements.
+func (r *Reservoir[T]) Sample() []T {
+	ret := make([]T, len(r.sample))
+	copy(ret, r.sample)
+	return ret
+}
+
+// ReservoirSample
+// Runs the stream and returns a uniform random sample of up to k of its elements without keeping the whole stream in memory.
+// Returns ErrInvalidArgument if k is negative.
+func ReservoirSample[T any](s Stream[T], k int, rng *rand.Rand) ([]T, error) {
+	r, err := NewReservoir[T](k, rng)
+	if err != nil {
+		return nil, err
+	}
+	s.ForEach(func(v T) bool {
+		r.Add(v)
+		return true
+	})
+	return r.Sample(), nil
+}
@@ -0,0 +1,128 @@
+package slices
+
+import (
+	"errors"
+	"github.com/rbnbr/go-utility/pkg/consts"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// newTestRand
+// Returns a deterministic random source.
+func newTestRand() *rand.Rand {
+	return rand.New(rand.NewSource(42))
+}
+
+// TestShuffle
+// Tests that Shuffle is a reproducible permutation which does not modify its input.
+func TestShuffle(t *testing.T) {
+	testSlice := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	equal := func(a, b int) bool {
+		return a == b
+	}
+
+	gotResult := Shuffle(testSlice, newTestRand())
+	expectedResult := Shuffle(testSlice, newTestRand())
+	if !Equal(gotResult, expectedResult, equal) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+	if !Equal(Sorted(gotResult, CompareNatural[int]), testSlice, equal) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, testSlice)
+	}
+	if testSlice[0] != 1 || testSlice[9] != 10 {
+		t.Errorf(consts.GotExpectedResultFmt, testSlice, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+	}
+}
+
+// TestSample
+// Tests that Sample returns distinct positions, SampleWithReplacement the requested size and both check k.
+func TestSample(t *testing.T) {
+	testSlice := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	rng := newTestRand()
+
+	for k := 0; k <= len(testSlice); k++ {
+		gotResult, _ := Sample(testSlice, k, rng)
+		if len(gotResult) != k || len(UniqueComparable(gotResult)) != k {
+			t.Errorf(consts.GotExpectedResultFmt, gotResult, "distinct sample of size k")
+		}
+	}
+
+	gotResult, _ := SampleWithReplacement(testSlice, 100, rng)
+	if len(gotResult) != 100 {
+		t.Errorf(consts.GotExpectedResultFmt, len(gotResult), 100)
+	}
+
+	_, gotError := Sample(testSlice, 11, rng)
+	if !errors.Is(gotError, ErrInvalidArgument) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, ErrInvalidArgument)
+	}
+
+	_, gotError = SampleWithReplacement([]int{}, 1, rng)
+	if !errors.Is(gotError, ErrInvalidArgument) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, ErrInvalidArgument)
+	}
+}
+
+// TestWeightedSample
+// Tests that the frequencies of WeightedSample approximate the weights and invalid weights are rejected.
+func TestWeightedSample(t *testing.T) {
+	testSlice := []string{"a", "b", "c", "d"}
+	weights := []float64{1, 0, 3, 6}
+	draws := 100000
+
+	gotResult, _ := WeightedSample(testSlice, weights, draws, newTestRand())
+	counts := CountBy(gotResult, func(s string) string {
+		return s
+	})
+	for i, s := range testSlice {
+		gotFrequency := float64(counts[s]) / float64(draws)
+		expectedFrequency := weights[i] / 10
+		if math.Abs(gotFrequency-expectedFrequency) > 0.01 {
+			t.Errorf(consts.GotExpectedResultFmt, gotFrequency, expectedFrequency)
+		}
+	}
+
+	for _, invalid := range [][]float64{{}, {0, 0}, {1, -1}, {math.NaN()}, {math.Inf(1)}} {
+		_, gotError := NewAliasTable(invalid)
+		if !errors.Is(gotError, ErrInvalidArgument) {
+			t.Errorf(consts.GotExpectedErrorFmt, gotError, ErrInvalidArgument)
+		}
+	}
+
+	_, gotError := WeightedSample(testSlice, weights[:3], 1, nil)
+	if !errors.Is(gotError, ErrInvalidArgument) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, ErrInvalidArgument)
+	}
+}
+
+// TestReservoirSample
+// Tests that every element ends up in the reservoir with roughly equal probability.
+func TestReservoirSample(t *testing.T) {
+	testSlice := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	rng := newTestRand()
+	runs := 20000
+	counts := make([]int, len(testSlice))
+
+	for i := 0; i < runs; i++ {
+		gotResult, _ := ReservoirSample(StreamOf(testSlice), 3, rng)
+		if len(gotResult) != 3 {
+			t.Fatalf(consts.GotExpectedResultFmt, len(gotResult), 3)
+		}
+		for _, v := range gotResult {
+			counts[v]++
+		}
+	}
+
+	for _, c := range counts {
+		gotFrequency := float64(c) / float64(runs)
+		if math.Abs(gotFrequency-0.3) > 0.02 {
+			t.Errorf(consts.GotExpectedResultFmt, gotFrequency, 0.3)
+		}
+	}
+
+	gotResult, _ := ReservoirSample(StreamOf(testSlice[:2]), 3, rng)
+	if len(gotResult) != 2 {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, testSlice[:2])
+	}
+}
@@ -0,0 +1,87 @@
+package slices
+
+import "sort"
+
+// The functions in this file expect the provided slice to be sorted according to the provided comparator,
+// or according to the natural ordering of the keys got via the accessor function for the *By versions.
+// The result is undefined if the slice is not sorted accordingly.
+
+// LowerBound
+// Returns the index of the first element which is not less than target.
+// Returns len(slice) if there is no such element, i.e., the index at which target would have to be inserted.
+func LowerBound[T any](slice []T, target T, comparator Comparator[T]) int {
+	return sort.Search(len(slice), func(i int) bool {
+		return comparator(slice[i], target) >= 0
+	})
+}
+
+// LowerBoundBy
+// Returns the index of the first element whose key is not less than key.
+// Returns len(slice) if there is no such element.
+func LowerBoundBy[T any, K Ordered](slice []T, key K, accessor func(T) K) int {
+	return sort.Search(len(slice), func(i int) bool {
+		return CompareNatural(accessor(slice[i]), key) >= 0
+	})
+}
+
+// UpperBound
+// Returns the index of the first element which is greater than target.
+// Returns len(slice) if there is no such element.
+func UpperBound[T any](slice []T, target T, comparator Comparator[T]) int {
+	return sort.Search(len(slice), func(i int) bool {
+		return comparator(slice[i], target) > 0
+	})
+}
+
+// UpperBoundBy
+// Returns the index of the first element whose key is greater than key.
+// Returns len(slice) if there is no such element.
+func UpperBoundBy[T any, K Ordered](slice []T, key K, accessor func(T) K) int {
+	return sort.Search(len(slice), func(i int) bool {
+		return CompareNatural(accessor(slice[i]), key) > 0
+	})
+}
+
+// EqualRange
+// Returns the range [start, end) of elements which are equal to target.
+// If there is no such element, start == end is the index at which target would have to be inserted.
+func EqualRange[T any](slice []T, target T, comparator Comparator[T]) (int, int) {
+	return LowerBound(slice, target, comparator), UpperBound(slice, target, comparator)
+}
+
+// EqualRangeBy
+// Returns the range [start, end) of elements whose key is equal to key.
+// If there is no such element, start == end is the index at which an element with that key would have to be inserted.
+func EqualRangeBy[T any, K Ordered](slice []T, key K, accessor func(T) K) (int, int) {
+	return LowerBoundBy(slice, key, accessor), UpperBoundBy(slice, key, accessor)
+}
+
+// BinarySearch
+// Searches for target and returns the index of the first element equal to target and true.
+// If target is not found, returns the index at which it would have to be inserted and false.
+func BinarySearch[T any](slice []T, target T, comparator Comparator[T]) (int, bool) {
+	i := LowerBound(slice, target, comparator)
+	return i, i < len(slice) && comparator(slice[i], target) == 0
+}
+
+// BinarySearchBy
+// Searches for an element with the provided key and returns the index of the first such element and true.
+// If no element is found, returns the index at which an element with that key would have to be inserted and false.
+func BinarySearchBy[T any, K Ordered](slice []T, key K, accessor func(T) K) (int, bool) {
+	i := LowerBoundBy(slice, key, accessor)
+	return i, i < len(slice) && CompareNatural(accessor(slice[i]), key) == 0
+}
+
+// InsertSorted
+// Inserts value into the sorted slice, after all elements equal to it, and returns the updated slice.
+// As with append, the provided slice may be modified and the result has to be used.
+func InsertSorted[T any](slice []T, value T, comparator Comparator[T]) []T {
+	i := UpperBound(slice, value, comparator)
+
+	var zero T
+	slice = append(slice, zero)
+	copy(slice[i+1:], slice[i:])
+	slice[i] = value
+
+	return slice
+}
@@ -0,0 +1,80 @@
+package slices
+
+import (
+	"github.com/rbnbr/go-utility/pkg/consts"
+	"testing"
+)
+
+// TestBounds
+// Tests LowerBound, UpperBound and EqualRange for present and absent values.
+func TestBounds(t *testing.T) {
+	testSlice := []int{1, 2, 2, 2, 5, 7}
+	comparator := Natural[int]()
+
+	gotStart, gotEnd := EqualRange(testSlice, 2, comparator)
+	if gotStart != 1 || gotEnd != 4 {
+		t.Errorf(consts.GotExpectedResultFmt, []int{gotStart, gotEnd}, []int{1, 4})
+	}
+
+	gotStart, gotEnd = EqualRange(testSlice, 4, comparator)
+	if gotStart != 4 || gotEnd != 4 {
+		t.Errorf(consts.GotExpectedResultFmt, []int{gotStart, gotEnd}, []int{4, 4})
+	}
+
+	gotResult := LowerBound(testSlice, 8, comparator)
+	if gotResult != len(testSlice) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, len(testSlice))
+	}
+
+	gotResult = UpperBound(testSlice, 0, comparator)
+	if gotResult != 0 {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, 0)
+	}
+}
+
+// TestBinarySearchBy
+// Tests BinarySearch and BinarySearchBy.
+func TestBinarySearchBy(t *testing.T) {
+	testSlice := []testEmployee{{"Amy", "A", 100}, {"Bob", "A", 200}, {"Cid", "B", 300}}
+	accessor := func(e testEmployee) int {
+		return e.Salary
+	}
+
+	gotIndex, gotFound := BinarySearchBy(testSlice, 200, accessor)
+	if gotIndex != 1 || !gotFound {
+		t.Errorf(consts.GotExpectedResultFmt, gotIndex, 1)
+	}
+
+	gotIndex, gotFound = BinarySearchBy(testSlice, 250, accessor)
+	if gotIndex != 2 || gotFound {
+		t.Errorf(consts.GotExpectedResultFmt, gotIndex, 2)
+	}
+
+	gotStart, gotEnd := EqualRangeBy(testSlice, "A", func(e testEmployee) string {
+		return e.Department
+	})
+	if gotStart != 0 || gotEnd != 2 {
+		t.Errorf(consts.GotExpectedResultFmt, []int{gotStart, gotEnd}, []int{0, 2})
+	}
+
+	gotIndex, gotFound = BinarySearch([]string{"a", "c"}, "b", CompareNatural[string])
+	if gotIndex != 1 || gotFound {
+		t.Errorf(consts.GotExpectedResultFmt, gotIndex, 1)
+	}
+}
+
+// TestInsertSorted
+// Tests that InsertSorted keeps the slice sorted.
+func TestInsertSorted(t *testing.T) {
+	gotResult := make([]int, 0)
+	for _, v := range []int{5, 1, 4, 1, 9, 0} {
+		gotResult = InsertSorted(gotResult, v, CompareNatural[int])
+	}
+
+	expectedResult := []int{0, 1, 1, 4, 5, 9}
+	if !Equal(gotResult, expectedResult, func(a, b int) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+}
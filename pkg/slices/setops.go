@@ -0,0 +1,349 @@
+package slices
+
+// The set operations in this file come in three flavours, following Unique, UniqueBy and UniqueComparable:
+//   - Xxx / XxxConfigurable compare elements using a predicate, which has to be an equivalence relation. Quadratic runtime.
+//   - XxxBy / XxxByConfigurable compare elements by a comparable key got via an accessor function. Linear runtime.
+//   - XxxComparable compare comparable elements using ==. Linear runtime.
+// All of them preserve the order in which elements have been seen first, elements of a before elements of b.
+// By default, they follow set semantics, i.e., the result does not contain duplicates.
+// The Configurable versions additionally allow multiset semantics in which every element counts as often as it occurs.
+
+// groupIDsBy
+// Assigns every element of a and b an id such that two elements share the same id iff their keys are equal.
+// Returns the ids of the elements of a, the ids of the elements of b, and the amount of distinct ids.
+func groupIDsBy[T any, K comparable](a []T, b []T, accessor func(T) K) ([]int, []int, int) {
+	ids := make(map[K]int)
+	assign := func(slice []T) []int {
+		ret := make([]int, len(slice))
+		for i := range slice {
+			k := accessor(slice[i])
+			id, ok := ids[k]
+			if !ok {
+				id = len(ids)
+				ids[k] = id
+			}
+			ret[i] = id
+		}
+		return ret
+	}
+
+	ia := assign(a)
+	ib := assign(b)
+	return ia, ib, len(ids)
+}
+
+// groupIDs
+// Same as groupIDsBy but uses a predicate to compare the elements with each other.
+func groupIDs[T any](a []T, b []T, predicate func(T, T) bool) ([]int, []int, int) {
+	representatives := make([]T, 0)
+	assign := func(slice []T) []int {
+		ret := make([]int, len(slice))
+		for i := range slice {
+			id := FindIndexGeneric(representatives, func(r T) bool {
+				return predicate(slice[i], r)
+			})
+			if id == -1 {
+				id = len(representatives)
+				representatives = append(representatives, slice[i])
+			}
+			ret[i] = id
+		}
+		return ret
+	}
+
+	ia := assign(a)
+	ib := assign(b)
+	return ia, ib, len(representatives)
+}
+
+// countIDs
+// Returns how often each of the n ids occurs in ids.
+func countIDs(ids []int, n int) []int {
+	counts := make([]int, n)
+	for _, id := range ids {
+		counts[id]++
+	}
+	return counts
+}
+
+// unionIDs
+// Returns the union of a and b given their ids.
+// In multiset mode, every id occurs max(count in a, count in b) times.
+func unionIDs[T any](a []T, b []T, ia []int, ib []int, n int, multiset bool) []T {
+	ret := make([]T, 0)
+	countsA := make([]int, n)
+	for i := range a {
+		if multiset || countsA[ia[i]] == 0 {
+			ret = append(ret, a[i])
+		}
+		countsA[ia[i]]++
+	}
+
+	countsB := make([]int, n)
+	for i := range b {
+		countsB[ib[i]]++
+		if (multiset && countsB[ib[i]] > countsA[ib[i]]) || (!multiset && countsA[ib[i]] == 0 && countsB[ib[i]] == 1) {
+			ret = append(ret, b[i])
+		}
+	}
+	return ret
+}
+
+// intersectIDs
+// Returns the elements of a which also occur in b given their ids.
+// In multiset mode, every id occurs min(count in a, count in b) times.
+func intersectIDs[T any](a []T, ia []int, ib []int, n int, multiset bool) []T {
+	ret := make([]T, 0)
+	remaining := countIDs(ib, n)
+	for i := range a {
+		if remaining[ia[i]] > 0 {
+			ret = append(ret, a[i])
+			if multiset {
+				remaining[ia[i]]--
+			} else {
+				remaining[ia[i]] = 0
+			}
+		}
+	}
+	return ret
+}
+
+// differenceIDs
+// Returns the elements of a which do not occur in b given their ids.
+// In multiset mode, every id occurs max(count in a - count in b, 0) times.
+func differenceIDs[T any](a []T, ia []int, ib []int, n int, multiset bool) []T {
+	ret := make([]T, 0)
+	remaining := countIDs(ib, n)
+	seen := make([]bool, n)
+	for i := range a {
+		if multiset {
+			if remaining[ia[i]] > 0 {
+				remaining[ia[i]]--
+			} else {
+				ret = append(ret, a[i])
+			}
+		} else if remaining[ia[i]] == 0 && !seen[ia[i]] {
+			ret = append(ret, a[i])
+		}
+		seen[ia[i]] = true
+	}
+	return ret
+}
+
+// symmetricDifferenceIDs
+// Returns the elements of a which do not occur in b followed by the elements of b which do not occur in a.
+func symmetricDifferenceIDs[T any](a []T, b []T, ia []int, ib []int, n int, multiset bool) []T {
+	return append(differenceIDs(a, ia, ib, n, multiset), differenceIDs(b, ib, ia, n, multiset)...)
+}
+
+// isSubsetIDs
+// Returns true if every id of ia also occurs in ib.
+func isSubsetIDs(ia []int, ib []int, n int) bool {
+	counts := countIDs(ib, n)
+	for _, id := range ia {
+		if counts[id] == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// disjointIDs
+// Returns true if no id occurs in both ia and ib.
+func disjointIDs(ia []int, ib []int, n int) bool {
+	counts := countIDs(ib, n)
+	for _, id := range ia {
+		if counts[id] != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// UnionConfigurable
+// Returns the elements of a and b, comparing elements using the predicate.
+func UnionConfigurable[T any](a []T, b []T, predicate func(T, T) bool, multiset bool) []T {
+	ia, ib, n := groupIDs(a, b, predicate)
+	return unionIDs(a, b, ia, ib, n, multiset)
+}
+
+// Union
+// Shorthand for UnionConfigurable(a, b, predicate, false)
+func Union[T any](a []T, b []T, predicate func(T, T) bool) []T {
+	return UnionConfigurable(a, b, predicate, false)
+}
+
+// UnionByConfigurable
+// Returns the elements of a and b, comparing elements by their keys.
+func UnionByConfigurable[T any, K comparable](a []T, b []T, accessor func(T) K, multiset bool) []T {
+	ia, ib, n := groupIDsBy(a, b, accessor)
+	return unionIDs(a, b, ia, ib, n, multiset)
+}
+
+// UnionBy
+// Shorthand for UnionByConfigurable(a, b, accessor, false)
+func UnionBy[T any, K comparable](a []T, b []T, accessor func(T) K) []T {
+	return UnionByConfigurable(a, b, accessor, false)
+}
+
+// UnionComparable
+// Shorthand for UnionByConfigurable(a, b, identity, false)
+func UnionComparable[T comparable](a []T, b []T) []T {
+	return UnionByConfigurable(a, b, identity[T], false)
+}
+
+// IntersectConfigurable
+// Returns the elements of a which also occur in b, comparing elements using the predicate.
+func IntersectConfigurable[T any](a []T, b []T, predicate func(T, T) bool, multiset bool) []T {
+	ia, ib, n := groupIDs(a, b, predicate)
+	return intersectIDs(a, ia, ib, n, multiset)
+}
+
+// Intersect
+// Shorthand for IntersectConfigurable(a, b, predicate, false)
+func Intersect[T any](a []T, b []T, predicate func(T, T) bool) []T {
+	return IntersectConfigurable(a, b, predicate, false)
+}
+
+// IntersectByConfigurable
+// Returns the elements of a which also occur in b, comparing elements by their keys.
+func IntersectByConfigurable[T any, K comparable](a []T, b []T, accessor func(T) K, multiset bool) []T {
+	ia, ib, n := groupIDsBy(a, b, accessor)
+	return intersectIDs(a, ia, ib, n, multiset)
+}
+
+// IntersectBy
+// Shorthand for IntersectByConfigurable(a, b, accessor, false)
+func IntersectBy[T any, K comparable](a []T, b []T, accessor func(T) K) []T {
+	return IntersectByConfigurable(a, b, accessor, false)
+}
+
+// IntersectComparable
+// Shorthand for IntersectByConfigurable(a, b, identity, false)
+func IntersectComparable[T comparable](a []T, b []T) []T {
+	return IntersectByConfigurable(a, b, identity[T], false)
+}
+
+// DifferenceConfigurable
+// Returns the elements of a which do not occur in b, comparing elements using the predicate.
+func DifferenceConfigurable[T any](a []T, b []T, predicate func(T, T) bool, multiset bool) []T {
+	ia, ib, n := groupIDs(a, b, predicate)
+	return differenceIDs(a, ia, ib, n, multiset)
+}
+
+// Difference
+// Shorthand for DifferenceConfigurable(a, b, predicate, false)
+func Difference[T any](a []T, b []T, predicate func(T, T) bool) []T {
+	return DifferenceConfigurable(a, b, predicate, false)
+}
+
+// DifferenceByConfigurable
+// Returns the elements of a which do not occur in b, comparing elements by their keys.
+func DifferenceByConfigurable[T any, K comparable](a []T, b []T, accessor func(T) K, multiset bool) []T {
+	ia, ib, n := groupIDsBy(a, b, accessor)
+	return differenceIDs(a, ia, ib, n, multiset)
+}
+
+// DifferenceBy
+// Shorthand for DifferenceByConfigurable(a, b, accessor, false)
+func DifferenceBy[T any, K comparable](a []T, b []T, accessor func(T) K) []T {
+	return DifferenceByConfigurable(a, b, accessor, false)
+}
+
+// DifferenceComparable
+// Shorthand for DifferenceByConfigurable(a, b, identity, false)
+func DifferenceComparable[T comparable](a []T, b []T) []T {
+	return DifferenceByConfigurable(a, b, identity[T], false)
+}
+
+// SymmetricDifferenceConfigurable
+// Returns the elements of a which do not occur in b followed by the elements of b which do not occur in a,
+// comparing elements using the predicate.
+func SymmetricDifferenceConfigurable[T any](a []T, b []T, predicate func(T, T) bool, multiset bool) []T {
+	ia, ib, n := groupIDs(a, b, predicate)
+	return symmetricDifferenceIDs(a, b, ia, ib, n, multiset)
+}
+
+// SymmetricDifference
+// Shorthand for SymmetricDifferenceConfigurable(a, b, predicate, false)
+func SymmetricDifference[T any](a []T, b []T, predicate func(T, T) bool) []T {
+	return SymmetricDifferenceConfigurable(a, b, predicate, false)
+}
+
+// SymmetricDifferenceByConfigurable
+// Returns the elements of a which do not occur in b followed by the elements of b which do not occur in a,
+// comparing elements by their keys.
+func SymmetricDifferenceByConfigurable[T any, K comparable](a []T, b []T, accessor func(T) K, multiset bool) []T {
+	ia, ib, n := groupIDsBy(a, b, accessor)
+	return symmetricDifferenceIDs(a, b, ia, ib, n, multiset)
+}
+
+// SymmetricDifferenceBy
+// Shorthand for SymmetricDifferenceByConfigurable(a, b, accessor, false)
+func SymmetricDifferenceBy[T any, K comparable](a []T, b []T, accessor func(T) K) []T {
+	return SymmetricDifferenceByConfigurable(a, b, accessor, false)
+}
+
+// SymmetricDifferenceComparable
+// Shorthand for SymmetricDifferenceByConfigurable(a, b, identity, false)
+func SymmetricDifferenceComparable[T comparable](a []T, b []T) []T {
+	return SymmetricDifferenceByConfigurable(a, b, identity[T], false)
+}
+
+// IsSubset
+// Returns true if every element of a also occurs in b, comparing elements using the predicate.
+func IsSubset[T any](a []T, b []T, predicate func(T, T) bool) bool {
+	ia, ib, n := groupIDs(a, b, predicate)
+	return isSubsetIDs(ia, ib, n)
+}
+
+// IsSubsetBy
+// Returns true if every element of a also occurs in b, comparing elements by their keys.
+func IsSubsetBy[T any, K comparable](a []T, b []T, accessor func(T) K) bool {
+	ia, ib, n := groupIDsBy(a, b, accessor)
+	return isSubsetIDs(ia, ib, n)
+}
+
+// IsSubsetComparable
+// Returns true if every element of a also occurs in b.
+func IsSubsetComparable[T comparable](a []T, b []T) bool {
+	return IsSubsetBy(a, b, identity[T])
+}
+
+// IsSuperset
+// Shorthand for IsSubset(b, a, predicate)
+func IsSuperset[T any](a []T, b []T, predicate func(T, T) bool) bool {
+	return IsSubset(b, a, predicate)
+}
+
+// IsSupersetBy
+// Shorthand for IsSubsetBy(b, a, accessor)
+func IsSupersetBy[T any, K comparable](a []T, b []T, accessor func(T) K) bool {
+	return IsSubsetBy(b, a, accessor)
+}
+
+// IsSupersetComparable
+// Shorthand for IsSubsetComparable(b, a)
+func IsSupersetComparable[T comparable](a []T, b []T) bool {
+	return IsSubsetComparable(b, a)
+}
+
+// Disjoint
+// Returns true if a and b have no element in common, comparing elements using the predicate.
+func Disjoint[T any](a []T, b []T, predicate func(T, T) bool) bool {
+	ia, ib, n := groupIDs(a, b, predicate)
+	return disjointIDs(ia, ib, n)
+}
+
+// DisjointBy
+// Returns true if a and b have no element in common, comparing elements by their keys.
+func DisjointBy[T any, K comparable](a []T, b []T, accessor func(T) K) bool {
+	ia, ib, n := groupIDsBy(a, b, accessor)
+	return disjointIDs(ia, ib, n)
+}
+
+// DisjointComparable
+// Returns true if a and b have no element in common.
+func DisjointComparable[T comparable](a []T, b []T) bool {
+	return DisjointBy(a, b, identity[T])
+}
@@ -0,0 +1,129 @@
+package slices
+
+import (
+	"github.com/rbnbr/go-utility/pkg/consts"
+	"strings"
+	"testing"
+)
+
+// TestSetOperationsComparable
+// Tests the comparable set operations with set semantics.
+func TestSetOperationsComparable(t *testing.T) {
+	testA := []int{1, 2, 2, 3, 4}
+	testB := []int{5, 4, 4, 2, 6}
+	equal := func(a, b int) bool {
+		return a == b
+	}
+
+	expectedResult := []int{1, 2, 3, 4, 5, 6}
+	gotResult := UnionComparable(testA, testB)
+	if !Equal(gotResult, expectedResult, equal) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	expectedResult = []int{2, 4}
+	gotResult = IntersectComparable(testA, testB)
+	if !Equal(gotResult, expectedResult, equal) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	expectedResult = []int{1, 3}
+	gotResult = DifferenceComparable(testA, testB)
+	if !Equal(gotResult, expectedResult, equal) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	expectedResult = []int{1, 3, 5, 6}
+	gotResult = SymmetricDifferenceComparable(testA, testB)
+	if !Equal(gotResult, expectedResult, equal) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+}
+
+// TestSetOperationsMultiset
+// Tests the set operations with multiset semantics.
+func TestSetOperationsMultiset(t *testing.T) {
+	testA := []int{1, 2, 2, 2, 3}
+	testB := []int{2, 2, 3, 3, 4}
+	equal := func(a, b int) bool {
+		return a == b
+	}
+
+	expectedResult := []int{1, 2, 2, 2, 3, 3, 4}
+	gotResult := UnionByConfigurable(testA, testB, identity[int], true)
+	if !Equal(gotResult, expectedResult, equal) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	expectedResult = []int{2, 2, 3}
+	gotResult = IntersectConfigurable(testA, testB, equal, true)
+	if !Equal(gotResult, expectedResult, equal) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	expectedResult = []int{1, 2}
+	gotResult = DifferenceByConfigurable(testA, testB, identity[int], true)
+	if !Equal(gotResult, expectedResult, equal) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	expectedResult = []int{1, 2, 3, 4}
+	gotResult = SymmetricDifferenceConfigurable(testA, testB, equal, true)
+	if !Equal(gotResult, expectedResult, equal) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+}
+
+// TestSetOperationsPredicate
+// Tests that the predicate and key based flavours agree and keep the first seen element.
+func TestSetOperationsPredicate(t *testing.T) {
+	testA := []string{"Go", "rust", "C"}
+	testB := []string{"RUST", "go", "Zig"}
+
+	expectedResult := []string{"Go", "rust"}
+	gotResult := Intersect(testA, testB, strings.EqualFold)
+	if !Equal(gotResult, expectedResult, func(a, b string) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	gotResult = IntersectBy(testA, testB, strings.ToLower)
+	if !Equal(gotResult, expectedResult, func(a, b string) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	expectedResult = []string{"Go", "rust", "C", "Zig"}
+	gotResult = Union(testA, testB, strings.EqualFold)
+	if !Equal(gotResult, expectedResult, func(a, b string) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+}
+
+// TestSubsetDisjoint
+// Tests IsSubset, IsSuperset and Disjoint.
+func TestSubsetDisjoint(t *testing.T) {
+	testA := []int{1, 2}
+	testB := []int{2, 1, 3}
+	testC := []int{4, 5}
+
+	if !IsSubsetComparable(testA, testB) || IsSubsetComparable(testB, testA) {
+		t.Errorf(consts.GotExpectedResultFmt, false, true)
+	}
+
+	if !IsSuperset(testB, testA, func(a, b int) bool { return a == b }) {
+		t.Errorf(consts.GotExpectedResultFmt, false, true)
+	}
+
+	if !IsSubsetComparable([]int{}, testA) {
+		t.Errorf(consts.GotExpectedResultFmt, false, true)
+	}
+
+	if !DisjointComparable(testA, testC) || DisjointBy(testA, testB, identity[int]) {
+		t.Errorf(consts.GotExpectedResultFmt, false, true)
+	}
+}
@@ -1,14 +1,15 @@
 package slices
 
 import (
-	"errors"
 	"fmt"
+	"github.com/rbnbr/go-utility/pkg/consts"
 	"regexp"
 )
 
 var (
-	ErrNil             = error(nil)                     // nil error
-	ErrInvalidArgument = errors.New("invalid argument") // there has been a problem with the provided argument
+	ErrNil             = error(nil)                // nil error
+	ErrInvalidArgument = consts.ErrInvalidArgument // there has been a problem with the provided argument
+	ErrDuplicateKey    = consts.ErrDuplicateKey    // a key occurred more than once where it has to be unique
 )
 
 // MakeUniqueStringSlice
@@ -17,8 +18,9 @@ var (
 // has occurred before to '{oldElement}{suffix}{count}', i.e., ["hello", "hello", "hello_"] with suffix "_"
 // will return ["hello", "hello_2", "hello_"]
 // If oldSlice contains elements that already match with regex: ^.*{suffix}[1-9]+$, it will return an error that the provided suffix cannot be used to make unique strings
+// See MakeUniqueStrings for a configurable alternative which does not fail on such elements.
 func MakeUniqueStringSlice(oldSlice []string, suffix string) ([]string, error) {
-	re := regexp.MustCompile(fmt.Sprintf("^.*%s[1-9]+$", suffix))
+	re := regexp.MustCompile(fmt.Sprintf("^.*%s[1-9]+$", regexp.QuoteMeta(suffix)))
 	if idx := FindIndexGeneric(oldSlice, func(s string) bool {
 		return re.MatchString(s)
 	}); idx != -1 {
@@ -135,6 +137,7 @@ func Equal[T1 any, T2 any](t1 []T1, t2 []T2, predicate func(T1, T2) bool) bool {
 // Is configurable to return the first or the last occurrence per element.
 // When chosen last occurrence, then the order is still the same as with first occurrence but the elements has been replaced.
 // Has quadratic runtime due to usage of slice for lookup and not map which allows elements that are non-comparable
+// See UniqueBy and UniqueComparable for linear time alternatives based on comparable keys.
 func UniqueConfigurable[T any](slice []T, predicate func(T, T) bool, firstOccurrence bool) []T {
 	unique := make([]T, 0)
 
@@ -233,6 +236,7 @@ func Reduce[T any, V any](slice []T, reduceFunc func(newValue *T, aggregate *V)
 // Provided a slice of slices, concatenate all slices in the provided slice to a single slice.
 // The slices should all be of the same type.
 // e.g., [[1, 2, 3, 4], [1, 2, 3, 4], [1, 2]] -> [1, 2, 3, 4, 1, 2, 3, 4, 1, 2]
+// Reallocates repeatedly for big inputs, see Concat for a preallocating alternative.
 func ConcatSlices[T any](slice [][]T) []T {
 	return Reduce(slice, func(newValue *[]T, aggregate *[]T) []T {
 		return append(*aggregate, *newValue...)
@@ -241,6 +245,7 @@ func ConcatSlices[T any](slice [][]T) []T {
 
 // Any
 // Returns true if any element of slice is true
+// See AnyGeneric for evaluating a predicate directly.
 func Any(slice []bool) bool {
 	for _, v := range slice {
 		if v {
@@ -253,6 +258,7 @@ func Any(slice []bool) bool {
 
 // All
 // Returns true if all elements of slice are true
+// See AllGeneric for evaluating a predicate directly.
 func All(slice []bool) bool {
 	for _, v := range slice {
 		if !v {
@@ -266,6 +272,7 @@ func All(slice []bool) bool {
 // GroupBy
 // Groups the elements of a slice to a map that maps their comparable identifier (got via the accessor function) to
 // a slice containing those elements
+// See GroupByOrdered or maps.GroupByToOrderedMap for results with a deterministic order.
 func GroupBy[T comparable, V any](slice []V, accessor func(v V) T) map[T][]V {
 	ret := map[T][]V{}
 
@@ -0,0 +1,355 @@
+package slices
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// IndexError
+// Wraps an error returned by a callback together with the index of the element for which the callback failed.
+type IndexError struct {
+	Index int
+	Err   error
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("index %d: %v", e.Index, e.Err)
+}
+
+func (e *IndexError) Unwrap() error {
+	return e.Err
+}
+
+// MultiError
+// Aggregates all errors that occurred while running a callback over a slice in "collect all errors" mode.
+// The errors are ordered by their index.
+type MultiError struct {
+	Errors []*IndexError
+}
+
+func (e *MultiError) Error() string {
+	msgs := Map(e.Errors, func(err **IndexError) string {
+		return (*err).Error()
+	})
+	return fmt.Sprintf("%d error(s) occurred: %s", len(e.Errors), strings.Join(msgs, "; "))
+}
+
+// Unwrap
+// Returns the aggregated errors.
+func (e *MultiError) Unwrap() []error {
+	return Map(e.Errors, func(err **IndexError) error {
+		return *err
+	})
+}
+
+// Is
+// Returns true if any of the aggregated errors matches target.
+func (e *MultiError) Is(target error) bool {
+	return ContainsGeneric(e.Errors, func(err *IndexError) bool {
+		return errors.Is(err, target)
+	})
+}
+
+// Indices
+// Returns the indices of all failed elements.
+func (e *MultiError) Indices() []int {
+	return Map(e.Errors, func(err **IndexError) int {
+		return (*err).Index
+	})
+}
+
+// errorCollector
+// Helper to implement the stop-at-first-error and collect-all-errors modes of the *Err functions.
+type errorCollector struct {
+	collectAll bool
+	errs       []*IndexError
+}
+
+// add
+// Records err for index i and returns true if the iteration should stop.
+func (c *errorCollector) add(i int, err error) bool {
+	c.errs = append(c.errs, &IndexError{Index: i, Err: err})
+	return !c.collectAll
+}
+
+// err
+// Returns nil if no error has been recorded, the *IndexError in stop-at-first-error mode and a *MultiError otherwise.
+func (c *errorCollector) err() error {
+	if len(c.errs) == 0 {
+		return nil
+	}
+	if !c.collectAll {
+		return c.errs[0]
+	}
+	return &MultiError{Errors: c.errs}
+}
+
+// MapErrConfigurable
+// Same as Map but the mapping function may fail.
+// If collectAll is false, stops at the first error and returns it wrapped in an *IndexError.
+// If collectAll is true, maps all elements, leaves the zero value at failed indices, and returns a *MultiError listing every failed index.
+func MapErrConfigurable[T any, V any](slice []T, mapping func(t *T) (V, error), collectAll bool) ([]V, error) {
+	return MapIErrConfigurable(slice, func(t *T, _ int) (V, error) {
+		return mapping(t)
+	}, collectAll)
+}
+
+// MapErr
+// Shorthand for MapErrConfigurable(slice, mapping, false)
+func MapErr[T any, V any](slice []T, mapping func(t *T) (V, error)) ([]V, error) {
+	return MapErrConfigurable(slice, mapping, false)
+}
+
+// MapErrAll
+// Shorthand for MapErrConfigurable(slice, mapping, true)
+func MapErrAll[T any, V any](slice []T, mapping func(t *T) (V, error)) ([]V, error) {
+	return MapErrConfigurable(slice, mapping, true)
+}
+
+// MapIErrConfigurable
+// Same as MapErrConfigurable but also passes an integer to the mapping function representing the current index.
+func MapIErrConfigurable[T any, V any](slice []T, mapping func(t *T, i int) (V, error), collectAll bool) ([]V, error) {
+	ret := make([]V, len(slice))
+	c := errorCollector{collectAll: collectAll}
+
+	for i := 0; i < len(slice); i++ {
+		v, err := mapping(&slice[i], i)
+		if err != nil {
+			if c.add(i, err) {
+				return nil, c.err()
+			}
+			continue
+		}
+		ret[i] = v
+	}
+
+	return ret, c.err()
+}
+
+// MapIErr
+// Shorthand for MapIErrConfigurable(slice, mapping, false)
+func MapIErr[T any, V any](slice []T, mapping func(t *T, i int) (V, error)) ([]V, error) {
+	return MapIErrConfigurable(slice, mapping, false)
+}
+
+// MapIErrAll
+// Shorthand for MapIErrConfigurable(slice, mapping, true)
+func MapIErrAll[T any, V any](slice []T, mapping func(t *T, i int) (V, error)) ([]V, error) {
+	return MapIErrConfigurable(slice, mapping, true)
+}
+
+// FilterErrConfigurable
+// Same as Filter but the predicate may fail.
+// If collectAll is false, stops at the first error and returns it wrapped in an *IndexError.
+// If collectAll is true, drops the failed elements and returns a *MultiError listing every failed index.
+func FilterErrConfigurable[T any](slice []T, predicate func(T) (bool, error), collectAll bool) ([]T, error) {
+	ret := make([]T, 0)
+	c := errorCollector{collectAll: collectAll}
+
+	for i, v := range slice {
+		ok, err := predicate(v)
+		if err != nil {
+			if c.add(i, err) {
+				return nil, c.err()
+			}
+			continue
+		}
+		if ok {
+			ret = append(ret, v)
+		}
+	}
+
+	return ret, c.err()
+}
+
+// FilterErr
+// Shorthand for FilterErrConfigurable(slice, predicate, false)
+func FilterErr[T any](slice []T, predicate func(T) (bool, error)) ([]T, error) {
+	return FilterErrConfigurable(slice, predicate, false)
+}
+
+// FilterErrAll
+// Shorthand for FilterErrConfigurable(slice, predicate, true)
+func FilterErrAll[T any](slice []T, predicate func(T) (bool, error)) ([]T, error) {
+	return FilterErrConfigurable(slice, predicate, true)
+}
+
+// ReduceErrConfigurable
+// Same as Reduce but the reduce function may fail.
+// If collectAll is false, stops at the first error and returns it wrapped in an *IndexError together with the aggregate so far.
+// If collectAll is true, skips the failed elements, i.e., keeps the aggregate unchanged, and returns a *MultiError listing every failed index.
+func ReduceErrConfigurable[T any, V any](slice []T, reduceFunc func(newValue *T, aggregate *V) (V, error), init V, collectAll bool) (V, error) {
+	c := errorCollector{collectAll: collectAll}
+
+	for i := 0; i < len(slice); i++ {
+		v, err := reduceFunc(&slice[i], &init)
+		if err != nil {
+			if c.add(i, err) {
+				return init, c.err()
+			}
+			continue
+		}
+		init = v
+	}
+
+	return init, c.err()
+}
+
+// ReduceErr
+// Shorthand for ReduceErrConfigurable(slice, reduceFunc, init, false)
+func ReduceErr[T any, V any](slice []T, reduceFunc func(newValue *T, aggregate *V) (V, error), init V) (V, error) {
+	return ReduceErrConfigurable(slice, reduceFunc, init, false)
+}
+
+// ReduceErrAll
+// Shorthand for ReduceErrConfigurable(slice, reduceFunc, init, true)
+func ReduceErrAll[T any, V any](slice []T, reduceFunc func(newValue *T, aggregate *V) (V, error), init V) (V, error) {
+	return ReduceErrConfigurable(slice, reduceFunc, init, true)
+}
+
+// GroupByErrConfigurable
+// Same as GroupBy but the accessor function may fail.
+// If collectAll is false, stops at the first error and returns it wrapped in an *IndexError.
+// If collectAll is true, leaves the failed elements ungrouped and returns a *MultiError listing every failed index.
+func GroupByErrConfigurable[T comparable, V any](slice []V, accessor func(v V) (T, error), collectAll bool) (map[T][]V, error) {
+	ret := map[T][]V{}
+	c := errorCollector{collectAll: collectAll}
+
+	for i := range slice {
+		k, err := accessor(slice[i])
+		if err != nil {
+			if c.add(i, err) {
+				return nil, c.err()
+			}
+			continue
+		}
+		ret[k] = append(ret[k], slice[i])
+	}
+
+	return ret, c.err()
+}
+
+// GroupByErr
+// Shorthand for GroupByErrConfigurable(slice, accessor, false)
+func GroupByErr[T comparable, V any](slice []V, accessor func(v V) (T, error)) (map[T][]V, error) {
+	return GroupByErrConfigurable(slice, accessor, false)
+}
+
+// GroupByErrAll
+// Shorthand for GroupByErrConfigurable(slice, accessor, true)
+func GroupByErrAll[T comparable, V any](slice []V, accessor func(v V) (T, error)) (map[T][]V, error) {
+	return GroupByErrConfigurable(slice, accessor, true)
+}
+
+// FindIndexErrConfigurable
+// Same as FindIndex but the predicate may fail.
+// If collectAll is false, stops at the first error and returns -1 and the error wrapped in an *IndexError.
+// If collectAll is true, treats failed indices as mismatches and continues the search.
+// The returned *MultiError then lists every index that failed before the match or the end of the search space.
+func FindIndexErrConfigurable(limit int, predicate func(int) (bool, error), collectAll bool) (int, error) {
+	c := errorCollector{collectAll: collectAll}
+
+	for i := 0; i < limit; i++ {
+		ok, err := predicate(i)
+		if err != nil {
+			if c.add(i, err) {
+				return -1, c.err()
+			}
+			continue
+		}
+		if ok {
+			return i, c.err()
+		}
+	}
+
+	return -1, c.err()
+}
+
+// FindIndexErr
+// Shorthand for FindIndexErrConfigurable(limit, predicate, false)
+func FindIndexErr(limit int, predicate func(int) (bool, error)) (int, error) {
+	return FindIndexErrConfigurable(limit, predicate, false)
+}
+
+// FindIndexErrAll
+// Shorthand for FindIndexErrConfigurable(limit, predicate, true)
+func FindIndexErrAll(limit int, predicate func(int) (bool, error)) (int, error) {
+	return FindIndexErrConfigurable(limit, predicate, true)
+}
+
+// FindIndexGenericErrConfigurable
+// Same as FindIndexErrConfigurable but with the element based predicate of FindIndexGeneric.
+func FindIndexGenericErrConfigurable[T any](slice []T, predicate func(T) (bool, error), collectAll bool) (int, error) {
+	return FindIndexErrConfigurable(len(slice), func(i int) (bool, error) {
+		return predicate(slice[i])
+	}, collectAll)
+}
+
+// FindIndexGenericErr
+// Shorthand for FindIndexGenericErrConfigurable(slice, predicate, false)
+func FindIndexGenericErr[T any](slice []T, predicate func(T) (bool, error)) (int, error) {
+	return FindIndexGenericErrConfigurable(slice, predicate, false)
+}
+
+// FindIndexGenericErrAll
+// Shorthand for FindIndexGenericErrConfigurable(slice, predicate, true)
+func FindIndexGenericErrAll[T any](slice []T, predicate func(T) (bool, error)) (int, error) {
+	return FindIndexGenericErrConfigurable(slice, predicate, true)
+}
+
+// CountErrConfigurable
+// Same as Count but the predicate may fail.
+// If collectAll is false, stops at the first error and returns it wrapped in an *IndexError together with the count so far.
+// If collectAll is true, does not count failed indices and returns a *MultiError listing every one of them.
+func CountErrConfigurable(limit int, predicate func(int) (bool, error), collectAll bool) (int, error) {
+	count := 0
+	c := errorCollector{collectAll: collectAll}
+
+	for i := 0; i < limit; i++ {
+		ok, err := predicate(i)
+		if err != nil {
+			if c.add(i, err) {
+				return count, c.err()
+			}
+			continue
+		}
+		if ok {
+			count += 1
+		}
+	}
+
+	return count, c.err()
+}
+
+// CountErr
+// Shorthand for CountErrConfigurable(limit, predicate, false)
+func CountErr(limit int, predicate func(int) (bool, error)) (int, error) {
+	return CountErrConfigurable(limit, predicate, false)
+}
+
+// CountErrAll
+// Shorthand for CountErrConfigurable(limit, predicate, true)
+func CountErrAll(limit int, predicate func(int) (bool, error)) (int, error) {
+	return CountErrConfigurable(limit, predicate, true)
+}
+
+// CountGenericErrConfigurable
+// Same as CountErrConfigurable but with the element based predicate of CountGeneric.
+func CountGenericErrConfigurable[T any](slice []T, predicate func(T) (bool, error), collectAll bool) (int, error) {
+	return CountErrConfigurable(len(slice), func(i int) (bool, error) {
+		return predicate(slice[i])
+	}, collectAll)
+}
+
+// CountGenericErr
+// Shorthand for CountGenericErrConfigurable(slice, predicate, false)
+func CountGenericErr[T any](slice []T, predicate func(T) (bool, error)) (int, error) {
+	return CountGenericErrConfigurable(slice, predicate, false)
+}
+
+// CountGenericErrAll
+// Shorthand for CountGenericErrConfigurable(slice, predicate, true)
+func CountGenericErrAll[T any](slice []T, predicate func(T) (bool, error)) (int, error) {
+	return CountGenericErrConfigurable(slice, predicate, true)
+}
@@ -0,0 +1,173 @@
+package slices
+
+import (
+	"errors"
+	"github.com/rbnbr/go-utility/pkg/consts"
+	"strconv"
+	"testing"
+)
+
+// TestMapErr
+// Tests MapErr and MapErrAll on a slice of strings that partially fails to parse.
+func TestMapErr(t *testing.T) {
+	testSlice := []string{"1", "2", "x", "4", "y"}
+	testMapping := func(s *string) (int, error) {
+		return strconv.Atoi(*s)
+	}
+
+	_, gotError := MapErr(testSlice, testMapping)
+	var indexErr *IndexError
+	if !errors.As(gotError, &indexErr) || indexErr.Index != 2 {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, "index 2")
+	}
+	if !errors.Is(gotError, strconv.ErrSyntax) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, strconv.ErrSyntax)
+	}
+
+	expectedResult := []int{1, 2, 0, 4, 0}
+	gotResult, gotError := MapErrAll(testSlice, testMapping)
+	if !Equal(gotResult, expectedResult, func(a int, b int) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	var multiErr *MultiError
+	expectedIndices := []int{2, 4}
+	if !errors.As(gotError, &multiErr) || !Equal(multiErr.Indices(), expectedIndices, func(a int, b int) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, expectedIndices)
+	}
+	if !errors.Is(gotError, strconv.ErrSyntax) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, strconv.ErrSyntax)
+	}
+
+	gotResult, gotError = MapErr(testSlice[:2], testMapping)
+	if !errors.Is(gotError, ErrNil) || len(gotResult) != 2 {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, ErrNil)
+	}
+}
+
+// TestFilterErr
+// Tests FilterErr and FilterErrAll.
+func TestFilterErr(t *testing.T) {
+	testSlice := []int{0, 1, 2, 3, 4, 5, 6}
+	testError := errors.New("test error")
+	testPredicate := func(i int) (bool, error) {
+		if i == 3 {
+			return false, testError
+		}
+		return i%2 == 0, nil
+	}
+
+	gotResult, gotError := FilterErr(testSlice, testPredicate)
+	if !errors.Is(gotError, testError) || gotResult != nil {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, testError)
+	}
+
+	expectedResult := []int{0, 2, 4, 6}
+	gotResult, gotError = FilterErrAll(testSlice, testPredicate)
+	if !errors.Is(gotError, testError) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, testError)
+	}
+	if !Equal(gotResult, expectedResult, func(a int, b int) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+}
+
+// TestReduceErr
+// Tests ReduceErr and ReduceErrAll.
+func TestReduceErr(t *testing.T) {
+	testSlice := []string{"1", "2", "x", "4"}
+	testReduceFunc := func(s *string, a *int) (int, error) {
+		v, err := strconv.Atoi(*s)
+		return (*a) + v, err
+	}
+
+	expectedResult := 3
+	gotResult, gotError := ReduceErr(testSlice, testReduceFunc, 0)
+	if !errors.Is(gotError, strconv.ErrSyntax) || gotResult != expectedResult {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	expectedResult = 7
+	gotResult, gotError = ReduceErrAll(testSlice, testReduceFunc, 0)
+	if !errors.Is(gotError, strconv.ErrSyntax) || gotResult != expectedResult {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+}
+
+// TestGroupByErr
+// Tests GroupByErr and GroupByErrAll.
+func TestGroupByErr(t *testing.T) {
+	testSlice := []string{"1", "a", "1", "2"}
+	testAccessor := func(s string) (int, error) {
+		return strconv.Atoi(s)
+	}
+
+	_, gotError := GroupByErr(testSlice, testAccessor)
+	var indexErr *IndexError
+	if !errors.As(gotError, &indexErr) || indexErr.Index != 1 {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, "index 1")
+	}
+
+	gotResult, gotError := GroupByErrAll(testSlice, testAccessor)
+	if !errors.Is(gotError, strconv.ErrSyntax) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, strconv.ErrSyntax)
+	}
+	if len(gotResult) != 2 || len(gotResult[1]) != 2 || len(gotResult[2]) != 1 {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, map[int][]string{1: {"1", "1"}, 2: {"2"}})
+	}
+}
+
+// TestFindIndexGenericErr
+// Tests FindIndexGenericErr and FindIndexGenericErrAll which continues past failing elements.
+func TestFindIndexGenericErr(t *testing.T) {
+	testSlice := []string{"1", "x", "3", "y"}
+	testPredicate := func(s string) (bool, error) {
+		v, err := strconv.Atoi(s)
+		return v == 3, err
+	}
+
+	gotResult, gotError := FindIndexGenericErr(testSlice, testPredicate)
+	if !errors.Is(gotError, strconv.ErrSyntax) || gotResult != -1 {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, -1)
+	}
+
+	expectedResult := 2
+	gotResult, gotError = FindIndexGenericErrAll(testSlice, testPredicate)
+	if gotResult != expectedResult {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	var multiErr *MultiError
+	if !errors.As(gotError, &multiErr) || len(multiErr.Errors) != 1 {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, "index 1")
+	}
+}
+
+// TestCountGenericErr
+// Tests CountGenericErr and CountGenericErrAll.
+func TestCountGenericErr(t *testing.T) {
+	testSlice := []string{"1", "x", "1", "y", "2"}
+	testPredicate := func(s string) (bool, error) {
+		v, err := strconv.Atoi(s)
+		return v == 1, err
+	}
+
+	expectedResult := 1
+	gotResult, gotError := CountGenericErr(testSlice, testPredicate)
+	if !errors.Is(gotError, strconv.ErrSyntax) || gotResult != expectedResult {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	expectedResult = 2
+	gotResult, gotError = CountGenericErrAll(testSlice, testPredicate)
+	var multiErr *MultiError
+	if !errors.As(gotError, &multiErr) || len(multiErr.Errors) != 2 || gotResult != expectedResult {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+}
@@ -43,6 +43,26 @@ func TestMakeUniqueStringSlice_failed_wrong_suffix_1(t *testing.T) {
 	}
 }
 
+// TestMakeUniqueStringSlice_success_special_suffix
+// Tests that suffixes containing special regex characters are matched literally.
+func TestMakeUniqueStringSlice_success_special_suffix(t *testing.T) {
+	testSlice := []string{"a", "a", "ab1"}
+	testSuffix := "."
+
+	expectedResult, expectedErr := []string{"a", "a.2", "ab1"}, ErrNil
+
+	gotResult, gotError := MakeUniqueStringSlice(testSlice, testSuffix)
+	if !errors.Is(gotError, expectedErr) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, expectedErr)
+	}
+
+	if !Equal(expectedResult, gotResult, func(a string, b string) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+}
+
 // TestFindIndex_found
 // Tests the find index function with integer slice and a predicate which should return something != -1, i.e., found the element
 func TestFindIndex_found(t *testing.T) {
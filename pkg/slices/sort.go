@@ -0,0 +1,148 @@
+package slices
+
+import "sort"
+
+// Ordered
+// Constraint for all types that support the operators < <= >= >.
+type Ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 |
+		~string
+}
+
+// Comparator
+// Compares a and b and returns a negative number if a < b, zero if a == b, and a positive number if a > b.
+type Comparator[T any] func(a, b T) int
+
+// CompareNatural
+// Comparator implementing the natural ordering of ordered types.
+// NaN values are considered equal to each other and less than any other value.
+func CompareNatural[T Ordered](a, b T) int {
+	aNaN, bNaN := a != a, b != b
+	switch {
+	case aNaN && bNaN:
+		return 0
+	case aNaN || a < b:
+		return -1
+	case bNaN || a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Natural
+// Returns a Comparator implementing the natural ordering of ordered types, see CompareNatural.
+func Natural[T Ordered]() Comparator[T] {
+	return CompareNatural[T]
+}
+
+// By
+// Returns a Comparator which compares elements by the natural ordering of their keys got via the accessor function.
+// Example:
+// SortBy(employees, By(func(e Employee) string { return e.Department }).ThenBy(By(func(e Employee) int { return e.Salary }).Reverse()))
+func By[T any, K Ordered](accessor func(T) K) Comparator[T] {
+	return func(a, b T) int {
+		return CompareNatural(accessor(a), accessor(b))
+	}
+}
+
+// ByComparator
+// Returns a Comparator which compares elements by their keys, got via the accessor function, using the comparator for keys.
+func ByComparator[T any, K any](accessor func(T) K, comparator Comparator[K]) Comparator[T] {
+	return func(a, b T) int {
+		return comparator(accessor(a), accessor(b))
+	}
+}
+
+// ThenBy
+// Returns a Comparator which compares using c and, in case of equality, falls back to next.
+func (c Comparator[T]) ThenBy(next Comparator[T]) Comparator[T] {
+	return func(a, b T) int {
+		if r := c(a, b); r != 0 {
+			return r
+		}
+		return next(a, b)
+	}
+}
+
+// Reverse
+// Returns a Comparator which reverses the order of c.
+func (c Comparator[T]) Reverse() Comparator[T] {
+	return func(a, b T) int {
+		return c(b, a)
+	}
+}
+
+// NilsFirst
+// Returns a Comparator for pointers which orders nil before any other value and compares the pointed to values using c.
+func NilsFirst[T any](c Comparator[T]) Comparator[*T] {
+	return func(a, b *T) int {
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return -1
+		case b == nil:
+			return 1
+		default:
+			return c(*a, *b)
+		}
+	}
+}
+
+// NilsLast
+// Returns a Comparator for pointers which orders nil after any other value and compares the pointed to values using c.
+func NilsLast[T any](c Comparator[T]) Comparator[*T] {
+	return func(a, b *T) int {
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return 1
+		case b == nil:
+			return -1
+		default:
+			return c(*a, *b)
+		}
+	}
+}
+
+// SortBy
+// Sorts the provided slice in place according to the comparator.
+// The sort is not guaranteed to be stable, see SortStableBy.
+func SortBy[T any](slice []T, comparator Comparator[T]) {
+	sort.Slice(slice, func(i, j int) bool {
+		return comparator(slice[i], slice[j]) < 0
+	})
+}
+
+// SortStableBy
+// Sorts the provided slice in place according to the comparator while keeping the original order of equal elements.
+func SortStableBy[T any](slice []T, comparator Comparator[T]) {
+	sort.SliceStable(slice, func(i, j int) bool {
+		return comparator(slice[i], slice[j]) < 0
+	})
+}
+
+// Sorted
+// Returns a sorted copy of the provided slice, leaving the provided slice unchanged.
+// The sort is stable.
+func Sorted[T any](slice []T, comparator Comparator[T]) []T {
+	ret := make([]T, len(slice))
+	copy(ret, slice)
+	SortStableBy(ret, comparator)
+	return ret
+}
+
+// IsSortedBy
+// Returns true if the provided slice is sorted according to the comparator.
+func IsSortedBy[T any](slice []T, comparator Comparator[T]) bool {
+	for i := 1; i < len(slice); i++ {
+		if comparator(slice[i-1], slice[i]) > 0 {
+			return false
+		}
+	}
+	return true
+}
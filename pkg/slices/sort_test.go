@@ -0,0 +1,104 @@
+package slices
+
+import (
+	"github.com/rbnbr/go-utility/pkg/consts"
+	"math"
+	"testing"
+)
+
+type testEmployee struct {
+	Name       string
+	Department string
+	Salary     int
+}
+
+// TestSortBy_multi_key
+// Tests sorting by department, then by descending salary, then by name.
+func TestSortBy_multi_key(t *testing.T) {
+	testSlice := []testEmployee{
+		{"Eve", "B", 100},
+		{"Bob", "A", 200},
+		{"Dan", "B", 300},
+		{"Amy", "A", 200},
+		{"Cid", "A", 500},
+	}
+
+	comparator := By(func(e testEmployee) string {
+		return e.Department
+	}).ThenBy(By(func(e testEmployee) int {
+		return e.Salary
+	}).Reverse()).ThenBy(By(func(e testEmployee) string {
+		return e.Name
+	}))
+
+	expectedResult := []string{"Cid", "Amy", "Bob", "Dan", "Eve"}
+
+	gotSorted := Sorted(testSlice, comparator)
+	gotResult := Map(gotSorted, func(e *testEmployee) string {
+		return e.Name
+	})
+	if !Equal(gotResult, expectedResult, func(a, b string) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	// the input is left untouched
+	if testSlice[0].Name != "Eve" {
+		t.Errorf(consts.GotExpectedResultFmt, testSlice[0].Name, "Eve")
+	}
+
+	if !IsSortedBy(gotSorted, comparator) || IsSortedBy(testSlice, comparator) {
+		t.Errorf(consts.GotExpectedResultFmt, IsSortedBy(gotSorted, comparator), true)
+	}
+
+	SortBy(testSlice, comparator)
+	if !IsSortedBy(testSlice, comparator) {
+		t.Errorf(consts.GotExpectedResultFmt, testSlice, gotSorted)
+	}
+}
+
+// TestSortStableBy
+// Tests that SortStableBy keeps the order of equal elements.
+func TestSortStableBy(t *testing.T) {
+	testSlice := []string{"bb", "a", "cc", "d", "aa"}
+
+	expectedResult := []string{"a", "d", "bb", "cc", "aa"}
+	SortStableBy(testSlice, By(func(s string) int {
+		return len(s)
+	}))
+
+	if !Equal(testSlice, expectedResult, func(a, b string) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, testSlice, expectedResult)
+	}
+}
+
+// TestNils
+// Tests NilsFirst and NilsLast.
+func TestNils(t *testing.T) {
+	one, two := 1, 2
+	testSlice := []*int{&two, nil, &one}
+
+	SortBy(testSlice, NilsFirst(Natural[int]()))
+	if testSlice[0] != nil || *testSlice[1] != 1 || *testSlice[2] != 2 {
+		t.Errorf(consts.GotExpectedResultFmt, testSlice, []interface{}{nil, 1, 2})
+	}
+
+	SortBy(testSlice, NilsLast(Natural[int]().Reverse()))
+	if *testSlice[0] != 2 || *testSlice[1] != 1 || testSlice[2] != nil {
+		t.Errorf(consts.GotExpectedResultFmt, testSlice, []interface{}{2, 1, nil})
+	}
+}
+
+// TestCompareNatural
+// Tests the natural ordering including NaN values.
+func TestCompareNatural(t *testing.T) {
+	testSlice := []float64{3, math.NaN(), -1, 2}
+
+	SortBy(testSlice, CompareNatural[float64])
+	if !math.IsNaN(testSlice[0]) || testSlice[1] != -1 || testSlice[3] != 3 {
+		t.Errorf(consts.GotExpectedResultFmt, testSlice, []float64{math.NaN(), -1, 2, 3})
+	}
+}
@@ -0,0 +1,104 @@
+package slices
+
+import "fmt"
+
+// Chunk
+// Splits the provided slice into consecutive chunks of size elements each. The last chunk may be shorter.
+// This is the opposite of ConcatSlices, i.e., ConcatSlices of the returned chunks equals slice.
+// The chunks share the backing array with the provided slice, i.e., changing an element of a chunk changes the provided slice and vice versa.
+// The capacity of every chunk is limited to its length, so appending to a chunk never overwrites elements of the next chunk.
+// Returns ErrInvalidArgument if size is smaller than 1.
+func Chunk[T any](slice []T, size int) ([][]T, error) {
+	if size < 1 {
+		return nil, fmt.Errorf("%w: chunk size has to be at least 1 but is %d", ErrInvalidArgument, size)
+	}
+
+	ret := make([][]T, 0, (len(slice)+size-1)/size)
+	for start := 0; start < len(slice); start += size {
+		end := start + size
+		if end > len(slice) {
+			end = len(slice)
+		}
+		ret = append(ret, slice[start:end:end])
+	}
+
+	return ret, nil
+}
+
+// Windows
+// Returns all windows of exactly size consecutive elements, where the start of each window is step elements after the start of the previous one.
+// I.e., with step < size the windows overlap, with step > size some elements are skipped.
+// Returns no windows if the provided slice is shorter than size.
+// The windows share the backing array with the provided slice, see Chunk.
+// Returns ErrInvalidArgument if size or step is smaller than 1.
+// Example:
+// Windows([]int{1, 2, 3, 4, 5}, 3, 1) -> [[1, 2, 3], [2, 3, 4], [3, 4, 5]]
+func Windows[T any](slice []T, size int, step int) ([][]T, error) {
+	if size < 1 {
+		return nil, fmt.Errorf("%w: window size has to be at least 1 but is %d", ErrInvalidArgument, size)
+	}
+	if step < 1 {
+		return nil, fmt.Errorf("%w: window step has to be at least 1 but is %d", ErrInvalidArgument, step)
+	}
+
+	ret := make([][]T, 0)
+	for start := 0; start+size <= len(slice); start += step {
+		ret = append(ret, slice[start:start+size:start+size])
+	}
+
+	return ret, nil
+}
+
+// Partition
+// Returns two new slices, the first containing the elements for which the predicate evaluates to true,
+// the second containing the remaining elements. Both keep the order of the provided slice.
+// The results do not share the backing array with the provided slice.
+func Partition[T any](slice []T, predicate func(T) bool) ([]T, []T) {
+	matching := make([]T, 0)
+	nonMatching := make([]T, 0)
+
+	for _, v := range slice {
+		if predicate(v) {
+			matching = append(matching, v)
+		} else {
+			nonMatching = append(nonMatching, v)
+		}
+	}
+
+	return matching, nonMatching
+}
+
+// SplitAt
+// Splits the provided slice into the elements before index and the elements from index on.
+// Both parts share the backing array with the provided slice, see Chunk.
+// Returns ErrInvalidArgument if index is not within [0, len(slice)].
+func SplitAt[T any](slice []T, index int) ([]T, []T, error) {
+	if index < 0 || index > len(slice) {
+		return nil, nil, fmt.Errorf("%w: index %d is out of range [0, %d]", ErrInvalidArgument, index, len(slice))
+	}
+
+	return slice[:index:index], slice[index:], nil
+}
+
+// SplitWhen
+// Splits the provided slice between every two adjacent elements for which the predicate evaluates to true.
+// Returns no parts for an empty slice.
+// The parts share the backing array with the provided slice, see Chunk.
+// Example:
+// SplitWhen([]int{1, 2, 4, 5, 7}, func(prev, next int) bool { return next-prev > 1 }) -> [[1, 2], [4, 5], [7]]
+func SplitWhen[T any](slice []T, predicate func(prev T, next T) bool) [][]T {
+	ret := make([][]T, 0)
+	if len(slice) == 0 {
+		return ret
+	}
+
+	start := 0
+	for i := 1; i < len(slice); i++ {
+		if predicate(slice[i-1], slice[i]) {
+			ret = append(ret, slice[start:i:i])
+			start = i
+		}
+	}
+
+	return append(ret, slice[start:])
+}
@@ -0,0 +1,118 @@
+package slices
+
+import (
+	"errors"
+	"github.com/rbnbr/go-utility/pkg/consts"
+	"testing"
+)
+
+// equalNested
+// Returns true if both nested int slices are equal.
+func equalNested(a [][]int, b [][]int) bool {
+	return Equal(a, b, func(x []int, y []int) bool {
+		return Equal(x, y, func(i int, i2 int) bool {
+			return i == i2
+		})
+	})
+}
+
+// TestChunk
+// Tests Chunk including the shorter last chunk, the aliasing behaviour and an invalid size.
+func TestChunk(t *testing.T) {
+	testSlice := []int{0, 1, 2, 3, 4, 5, 6}
+
+	expectedResult := [][]int{{0, 1, 2}, {3, 4, 5}, {6}}
+	gotResult, gotError := Chunk(testSlice, 3)
+	if !errors.Is(gotError, ErrNil) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, ErrNil)
+	}
+	if !equalNested(gotResult, expectedResult) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	// appending to a chunk must not overwrite the next chunk
+	_ = append(gotResult[0], 100)
+	if testSlice[3] != 3 {
+		t.Errorf(consts.GotExpectedResultFmt, testSlice[3], 3)
+	}
+
+	_, gotError = Chunk(testSlice, 0)
+	if !errors.Is(gotError, ErrInvalidArgument) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, ErrInvalidArgument)
+	}
+}
+
+// TestWindows
+// Tests overlapping and skipping windows.
+func TestWindows(t *testing.T) {
+	testSlice := []int{1, 2, 3, 4, 5}
+
+	expectedResult := [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}
+	gotResult, _ := Windows(testSlice, 3, 1)
+	if !equalNested(gotResult, expectedResult) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	expectedResult = [][]int{{1}, {4}}
+	gotResult, _ = Windows(testSlice, 1, 3)
+	if !equalNested(gotResult, expectedResult) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	gotResult, _ = Windows(testSlice, 6, 1)
+	if len(gotResult) != 0 {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, [][]int{})
+	}
+
+	_, gotError := Windows(testSlice, 2, 0)
+	if !errors.Is(gotError, ErrInvalidArgument) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, ErrInvalidArgument)
+	}
+}
+
+// TestPartition
+// Tests the Partition function.
+func TestPartition(t *testing.T) {
+	testSlice := []int{0, 1, 2, 3, 4, 5}
+
+	expectedMatching := []int{0, 2, 4}
+	expectedNonMatching := []int{1, 3, 5}
+	gotMatching, gotNonMatching := Partition(testSlice, func(i int) bool {
+		return i%2 == 0
+	})
+
+	if !equalNested([][]int{gotMatching, gotNonMatching}, [][]int{expectedMatching, expectedNonMatching}) {
+		t.Errorf(consts.GotExpectedResultFmt, [][]int{gotMatching, gotNonMatching}, [][]int{expectedMatching, expectedNonMatching})
+	}
+}
+
+// TestSplit
+// Tests SplitAt and SplitWhen.
+func TestSplit(t *testing.T) {
+	testSlice := []int{1, 2, 4, 5, 7}
+
+	gotLeft, gotRight, gotError := SplitAt(testSlice, 2)
+	if !errors.Is(gotError, ErrNil) || !equalNested([][]int{gotLeft, gotRight}, [][]int{{1, 2}, {4, 5, 7}}) {
+		t.Errorf(consts.GotExpectedResultFmt, [][]int{gotLeft, gotRight}, [][]int{{1, 2}, {4, 5, 7}})
+	}
+
+	_, _, gotError = SplitAt(testSlice, 6)
+	if !errors.Is(gotError, ErrInvalidArgument) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, ErrInvalidArgument)
+	}
+
+	expectedResult := [][]int{{1, 2}, {4, 5}, {7}}
+	gotResult := SplitWhen(testSlice, func(prev, next int) bool {
+		return next-prev > 1
+	})
+	if !equalNested(gotResult, expectedResult) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	gotResult = SplitWhen([]int{}, func(prev, next int) bool {
+		return true
+	})
+	if len(gotResult) != 0 {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, [][]int{})
+	}
+}
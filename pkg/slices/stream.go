@@ -0,0 +1,280 @@
+package slices
+
+import "fmt"
+
+// Stream
+// A lazy sequence of elements.
+// Intermediate operations like Filter, Take or StreamMap only describe the pipeline and do not allocate intermediate slices.
+// Terminal operations like Collect, Count or StreamReduce run the pipeline, passing each element through all stages
+// exactly once before the next element is pulled from the source.
+// Streams created from slices, maps or other re-iterable sources can be run multiple times, streams created from
+// channels or generators can only be run once.
+type Stream[T any] struct {
+	each func(yield func(T) bool)
+}
+
+// iterate
+// Runs the stream, the zero value of Stream is treated as an empty stream.
+func (s Stream[T]) iterate(yield func(T) bool) {
+	if s.each != nil {
+		s.each(yield)
+	}
+}
+
+// StreamOf
+// Returns a stream over the elements of slice.
+// The slice is not copied, i.e., changes to the slice before running the stream are visible.
+func StreamOf[T any](slice []T) Stream[T] {
+	return Stream[T]{each: func(yield func(T) bool) {
+		for i := range slice {
+			if !yield(slice[i]) {
+				return
+			}
+		}
+	}}
+}
+
+// StreamOfMap
+// Returns a stream over the entries of someMap, combined to a single element using the provided combine function.
+// As with any map iteration, the order of the elements is not specified.
+// Example:
+// StreamOfMap(someMap, func(k string, v int) string { return fmt.Sprintf("%s=%d", k, v) })
+func StreamOfMap[K comparable, V any, T any](someMap map[K]V, combine func(k K, v V) T) Stream[T] {
+	return Stream[T]{each: func(yield func(T) bool) {
+		for k, v := range someMap {
+			if !yield(combine(k, v)) {
+				return
+			}
+		}
+	}}
+}
+
+// StreamOfChannel
+// Returns a stream over the elements received from ch until it is closed.
+// If the stream stops early, e.g., due to Take or First, the remaining elements are left in the channel.
+func StreamOfChannel[T any](ch <-chan T) Stream[T] {
+	return Stream[T]{each: func(yield func(T) bool) {
+		for v := range ch {
+			if !yield(v) {
+				return
+			}
+		}
+	}}
+}
+
+// StreamOfGenerator
+// Returns a stream over the elements returned by generator until it returns false as its second value.
+func StreamOfGenerator[T any](generator func() (T, bool)) Stream[T] {
+	return Stream[T]{each: func(yield func(T) bool) {
+		for {
+			v, ok := generator()
+			if !ok || !yield(v) {
+				return
+			}
+		}
+	}}
+}
+
+// Filter
+// Returns a stream which only contains those elements for which the given predicate function evaluates to true.
+func (s Stream[T]) Filter(predicate func(T) bool) Stream[T] {
+	return Stream[T]{each: func(yield func(T) bool) {
+		s.iterate(func(v T) bool {
+			if predicate(v) {
+				return yield(v)
+			}
+			return true
+		})
+	}}
+}
+
+// Take
+// Returns a stream which contains at most the first n elements of s.
+// The source is not consumed any further once n elements have been passed on.
+func (s Stream[T]) Take(n int) Stream[T] {
+	return Stream[T]{each: func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		taken := 0
+		s.iterate(func(v T) bool {
+			taken++
+			return yield(v) && taken < n
+		})
+	}}
+}
+
+// Skip
+// Returns a stream which omits the first n elements of s.
+func (s Stream[T]) Skip(n int) Stream[T] {
+	return Stream[T]{each: func(yield func(T) bool) {
+		skipped := 0
+		s.iterate(func(v T) bool {
+			if skipped < n {
+				skipped++
+				return true
+			}
+			return yield(v)
+		})
+	}}
+}
+
+// Distinct
+// Returns a stream which omits every element that is equal to a previously passed element,
+// using the predicate function to compare equality between two elements.
+// Has quadratic runtime, see StreamDistinctBy for a linear alternative.
+func (s Stream[T]) Distinct(predicate func(T, T) bool) Stream[T] {
+	return Stream[T]{each: func(yield func(T) bool) {
+		seen := make([]T, 0)
+		s.iterate(func(v T) bool {
+			if ContainsGeneric(seen, func(u T) bool {
+				return predicate(v, u)
+			}) {
+				return true
+			}
+			seen = append(seen, v)
+			return yield(v)
+		})
+	}}
+}
+
+// ForEach
+// Runs the stream and calls fn for every element.
+// Stops early if fn returns false.
+func (s Stream[T]) ForEach(fn func(T) bool) {
+	s.iterate(fn)
+}
+
+// Collect
+// Runs the stream and returns its elements as a new slice.
+func (s Stream[T]) Collect() []T {
+	ret := make([]T, 0)
+	s.iterate(func(v T) bool {
+		ret = append(ret, v)
+		return true
+	})
+	return ret
+}
+
+// Count
+// Runs the stream and returns the amount of elements.
+func (s Stream[T]) Count() int {
+	count := 0
+	s.iterate(func(T) bool {
+		count += 1
+		return true
+	})
+	return count
+}
+
+// First
+// Returns the first element of the stream and true, or the zero value and false if the stream is empty.
+func (s Stream[T]) First() (T, bool) {
+	var (
+		ret   T
+		found bool
+	)
+	s.iterate(func(v T) bool {
+		ret, found = v, true
+		return false
+	})
+	return ret, found
+}
+
+// Any
+// Returns true if the predicate evaluates true for any element of the stream.
+// Stops at the first match.
+func (s Stream[T]) Any(predicate func(T) bool) bool {
+	_, found := s.Filter(predicate).First()
+	return found
+}
+
+// All
+// Returns true if the predicate evaluates true for all elements of the stream.
+// Stops at the first mismatch.
+func (s Stream[T]) All(predicate func(T) bool) bool {
+	return !s.Any(func(v T) bool {
+		return !predicate(v)
+	})
+}
+
+// StreamMap
+// Returns a stream which applies the function 'mapping' to each element of s.
+// Same as Map, the mapping function receives a pointer to a copy of the element.
+func StreamMap[T any, V any](s Stream[T], mapping func(t *T) V) Stream[V] {
+	return Stream[V]{each: func(yield func(V) bool) {
+		s.iterate(func(v T) bool {
+			return yield(mapping(&v))
+		})
+	}}
+}
+
+// StreamFlatMap
+// Returns a stream which applies the function 'mapping' to each element of s and passes on all elements of the returned slices.
+func StreamFlatMap[T any, V any](s Stream[T], mapping func(t *T) []V) Stream[V] {
+	return Stream[V]{each: func(yield func(V) bool) {
+		s.iterate(func(v T) bool {
+			for _, m := range mapping(&v) {
+				if !yield(m) {
+					return false
+				}
+			}
+			return true
+		})
+	}}
+}
+
+// StreamDistinctBy
+// Returns a stream which omits every element whose key, got via the accessor function, has been seen before.
+func StreamDistinctBy[T any, K comparable](s Stream[T], accessor func(T) K) Stream[T] {
+	return Stream[T]{each: func(yield func(T) bool) {
+		seen := make(map[K]struct{})
+		s.iterate(func(v T) bool {
+			k := accessor(v)
+			if _, ok := seen[k]; ok {
+				return true
+			}
+			seen[k] = struct{}{}
+			return yield(v)
+		})
+	}}
+}
+
+// StreamChunk
+// Returns a stream of slices containing size consecutive elements of s each. The last chunk may be shorter.
+// Every chunk is a newly allocated slice.
+// Returns ErrInvalidArgument if size is smaller than 1.
+func StreamChunk[T any](s Stream[T], size int) (Stream[[]T], error) {
+	if size < 1 {
+		return Stream[[]T]{}, fmt.Errorf("%w: chunk size has to be at least 1 but is %d", ErrInvalidArgument, size)
+	}
+
+	return Stream[[]T]{each: func(yield func([]T) bool) {
+		chunk := make([]T, 0, size)
+		stopped := false
+		s.iterate(func(v T) bool {
+			chunk = append(chunk, v)
+			if len(chunk) < size {
+				return true
+			}
+			full := chunk
+			chunk = make([]T, 0, size)
+			stopped = !yield(full)
+			return !stopped
+		})
+		if !stopped && len(chunk) > 0 {
+			yield(chunk)
+		}
+	}}, nil
+}
+
+// StreamReduce
+// Runs the stream and combines its elements the same way Reduce does for slices.
+// init is the initial aggregate value
+func StreamReduce[T any, V any](s Stream[T], reduceFunc func(newValue *T, aggregate *V) V, init V) V {
+	s.iterate(func(v T) bool {
+		init = reduceFunc(&v, &init)
+		return true
+	})
+	return init
+}
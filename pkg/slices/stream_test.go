@@ -0,0 +1,154 @@
+package slices
+
+import (
+	"errors"
+	"github.com/rbnbr/go-utility/pkg/consts"
+	"testing"
+)
+
+// TestStream_pipeline
+// Tests a chained pipeline and that every element passes the pipeline only once while it is still needed.
+func TestStream_pipeline(t *testing.T) {
+	testSlice := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+
+	calls := 0
+	expectedResult := []int{4, 8, 12}
+	gotResult := StreamMap(StreamOf(testSlice).Filter(func(i int) bool {
+		calls++
+		return i%2 == 0
+	}).Skip(1), func(i *int) int {
+		return (*i) * 2
+	}).Take(3).Collect()
+
+	if !Equal(gotResult, expectedResult, func(a int, b int) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	// the source is not consumed beyond the element 6
+	expectedCalls := 7
+	if calls != expectedCalls {
+		t.Errorf(consts.GotExpectedResultFmt, calls, expectedCalls)
+	}
+}
+
+// TestStream_sources
+// Tests the channel, generator and map sources.
+func TestStream_sources(t *testing.T) {
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	close(ch)
+
+	expectedResult := 3
+	gotResult := StreamOfChannel(ch).Count()
+	if gotResult != expectedResult {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	counter := 0
+	gen := StreamOfGenerator(func() (int, bool) {
+		counter++
+		return counter, true
+	})
+
+	expectedResultGen := []int{1, 2, 3, 4}
+	gotResultGen := gen.Take(4).Collect()
+	if !Equal(gotResultGen, expectedResultGen, func(a int, b int) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResultGen, expectedResultGen)
+	}
+
+	testMap := map[string]int{"a": 1, "b": 2, "c": 3}
+	expectedResultMap := 6
+	gotResultMap := StreamReduce(StreamOfMap(testMap, func(k string, v int) int {
+		return v
+	}), func(v *int, a *int) int {
+		return (*a) + (*v)
+	}, 0)
+	if gotResultMap != expectedResultMap {
+		t.Errorf(consts.GotExpectedResultFmt, gotResultMap, expectedResultMap)
+	}
+}
+
+// TestStream_distinct_flatmap
+// Tests Distinct, StreamDistinctBy and StreamFlatMap.
+func TestStream_distinct_flatmap(t *testing.T) {
+	testSlice := [][]int{{1, 2}, {2, 3}, {}, {3, 4, 1}}
+
+	expectedResult := []int{1, 2, 3, 4}
+	flat := StreamFlatMap(StreamOf(testSlice), func(s *[]int) []int {
+		return *s
+	})
+
+	gotResult := flat.Distinct(func(a int, b int) bool {
+		return a == b
+	}).Collect()
+	if !Equal(gotResult, expectedResult, func(a int, b int) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	gotResult = StreamDistinctBy(flat, func(i int) int {
+		return i
+	}).Collect()
+	if !Equal(gotResult, expectedResult, func(a int, b int) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+}
+
+// TestStreamChunk
+// Tests StreamChunk including the shorter last chunk and an invalid size.
+func TestStreamChunk(t *testing.T) {
+	testSlice := []int{0, 1, 2, 3, 4, 5, 6}
+
+	expectedResult := [][]int{{0, 1, 2}, {3, 4, 5}, {6}}
+	chunks, gotError := StreamChunk(StreamOf(testSlice), 3)
+	if !errors.Is(gotError, ErrNil) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, ErrNil)
+	}
+
+	gotResult := chunks.Collect()
+	if !Equal(gotResult, expectedResult, func(a []int, b []int) bool {
+		return Equal(a, b, func(i int, i2 int) bool {
+			return i == i2
+		})
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	_, gotError = StreamChunk(StreamOf(testSlice), 0)
+	if !errors.Is(gotError, ErrInvalidArgument) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, ErrInvalidArgument)
+	}
+}
+
+// TestStream_terminal
+// Tests the First, Any and All terminal operations.
+func TestStream_terminal(t *testing.T) {
+	testSlice := []int{3, 5, 7, 8}
+
+	gotFirst, gotOk := StreamOf(testSlice).Skip(2).First()
+	if gotFirst != 7 || !gotOk {
+		t.Errorf(consts.GotExpectedResultFmt, gotFirst, 7)
+	}
+
+	_, gotOk = StreamOf([]int{}).First()
+	if gotOk {
+		t.Errorf(consts.GotExpectedResultFmt, gotOk, false)
+	}
+
+	if !StreamOf(testSlice).Any(func(i int) bool { return i%2 == 0 }) {
+		t.Errorf(consts.GotExpectedResultFmt, false, true)
+	}
+
+	if StreamOf(testSlice).All(func(i int) bool { return i%2 == 1 }) {
+		t.Errorf(consts.GotExpectedResultFmt, true, false)
+	}
+}
@@ -0,0 +1,94 @@
+package slices
+
+// UniqueByDetailed
+// Hash based alternative to UniqueConfigurable which runs in linear time.
+// Two elements are considered equal if their comparable keys, got via the accessor function, are equal.
+// Is configurable to return the first or the last occurrence per key, with the same semantics as UniqueConfigurable,
+// i.e., when chosen last occurrence, the order is still the same as with first occurrence but the elements have been replaced.
+// Next to the unique elements, it returns:
+//   - duplicates: the elements which have been removed, in the order of the provided slice
+//   - mapping: a slice of len(slice) which maps the index of every element in the provided slice to the index of its key in unique
+func UniqueByDetailed[T any, K comparable](slice []T, accessor func(T) K, firstOccurrence bool) (unique []T, duplicates []T, mapping []int) {
+	unique = make([]T, 0)
+	mapping = make([]int, len(slice))
+
+	// index in slice of the element currently kept per position in unique
+	kept := make([]int, 0)
+	// position of a key in unique
+	positions := make(map[K]int)
+
+	for i := range slice {
+		k := accessor(slice[i])
+		if idx, ok := positions[k]; !ok {
+			positions[k] = len(unique)
+			mapping[i] = len(unique)
+			unique = append(unique, slice[i])
+			kept = append(kept, i)
+		} else {
+			mapping[i] = idx
+			if !firstOccurrence {
+				unique[idx] = slice[i]
+				kept[idx] = i
+			}
+		}
+	}
+
+	duplicates = make([]T, 0, len(slice)-len(unique))
+	for i := range slice {
+		if kept[mapping[i]] != i {
+			duplicates = append(duplicates, slice[i])
+		}
+	}
+
+	return unique, duplicates, mapping
+}
+
+// UniqueByConfigurable
+// Same as UniqueByDetailed but only returns the unique elements.
+func UniqueByConfigurable[T any, K comparable](slice []T, accessor func(T) K, firstOccurrence bool) []T {
+	unique := make([]T, 0)
+	positions := make(map[K]int)
+
+	for i := range slice {
+		k := accessor(slice[i])
+		if idx, ok := positions[k]; !ok {
+			positions[k] = len(unique)
+			unique = append(unique, slice[i])
+		} else if !firstOccurrence {
+			unique[idx] = slice[i]
+		}
+	}
+
+	return unique
+}
+
+// UniqueBy
+// Shorthand for UniqueByConfigurable(slice, accessor, true)
+func UniqueBy[T any, K comparable](slice []T, accessor func(T) K) []T {
+	return UniqueByConfigurable(slice, accessor, true)
+}
+
+// UniqueByLast
+// Shorthand for UniqueByConfigurable(slice, accessor, false)
+func UniqueByLast[T any, K comparable](slice []T, accessor func(T) K) []T {
+	return UniqueByConfigurable(slice, accessor, false)
+}
+
+// UniqueComparable
+// Returns a new slice with only elements which do not occur twice, keeping the first occurrence.
+// Same as UniqueFirst but uses == for comparison and runs in linear time.
+func UniqueComparable[T comparable](slice []T) []T {
+	return UniqueByConfigurable(slice, identity[T], true)
+}
+
+// UniqueComparableLast
+// Same as UniqueComparable but keeps the last occurrence, see UniqueLast.
+func UniqueComparableLast[T comparable](slice []T) []T {
+	return UniqueByConfigurable(slice, identity[T], false)
+}
+
+// identity
+// Returns t, used as accessor for comparable elements.
+func identity[T any](t T) T {
+	return t
+}
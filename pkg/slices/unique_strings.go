@@ -0,0 +1,137 @@
+package slices
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NamingStrategy
+// Returns the name for the count-th occurrence of value, used by MakeUniqueStrings to rename duplicates.
+// Has to return different names for different counts.
+type NamingStrategy func(value string, count int) string
+
+// SuffixNaming
+// Returns a NamingStrategy producing '{value}{suffix}{count}', as used by MakeUniqueStringSlice.
+func SuffixNaming(suffix string) NamingStrategy {
+	return func(value string, count int) string {
+		return fmt.Sprintf("%s%s%d", value, suffix, count)
+	}
+}
+
+// ZeroPaddedNaming
+// Returns a NamingStrategy producing '{value}{suffix}{count}' with count padded with zeros to at least width digits,
+// e.g., "file_007".
+func ZeroPaddedNaming(suffix string, width int) NamingStrategy {
+	return func(value string, count int) string {
+		return fmt.Sprintf("%s%s%0*d", value, suffix, width, count)
+	}
+}
+
+// ParenthesesNaming
+// Returns a NamingStrategy producing '{value} ({count})', e.g., "name (2)".
+func ParenthesesNaming() NamingStrategy {
+	return func(value string, count int) string {
+		return fmt.Sprintf("%s (%d)", value, count)
+	}
+}
+
+// UniqueStringsOptions
+// Configures MakeUniqueStrings. The zero value renames duplicates like MakeUniqueStringSlice with suffix "_".
+type UniqueStringsOptions struct {
+	// Naming creates the new names for duplicates. Defaults to SuffixNaming("_") if nil.
+	Naming NamingStrategy
+	// FirstCount is the count passed to Naming for the first renamed occurrence of a value. Defaults to 2 if smaller than 1.
+	FirstCount int
+	// RenameFirst also renames the first occurrence of every value that occurs more than once, e.g., ["a_1", "a_2"] instead of ["a", "a_2"].
+	RenameFirst bool
+	// CaseInsensitive considers two strings that only differ in case as a collision.
+	CaseInsensitive bool
+	// Reserved contains names which must not occur in the result. Elements equal to a reserved name are renamed as well.
+	Reserved []string
+}
+
+// MakeUniqueStrings
+// Returns a copy of values where every element is unique, renaming duplicates according to options.
+// Every occurrence of a value except the first one, and optionally the first one too, is renamed using the naming strategy,
+// counting up from FirstCount. If a generated name collides with another element, a reserved or an already generated name,
+// the count is increased until the name is free, i.e., unlike MakeUniqueStringSlice, already suffixed elements are no problem.
+// Next to the new names, returns the mapping from the index of every renamed element to its new name.
+// Returns ErrInvalidArgument if the naming strategy does not produce a free name, e.g., because it ignores the count.
+// Example:
+// MakeUniqueStrings([]string{"a", "a", "a (2)"}, UniqueStringsOptions{Naming: ParenthesesNaming()}) -> ["a", "a (3)", "a (2)"], {1: "a (3)"}
+func MakeUniqueStrings(values []string, options UniqueStringsOptions) ([]string, map[int]string, error) {
+	naming := options.Naming
+	if naming == nil {
+		naming = SuffixNaming("_")
+	}
+	firstCount := options.FirstCount
+	if firstCount < 1 {
+		firstCount = 2
+	}
+	key := func(s string) string {
+		return s
+	}
+	if options.CaseInsensitive {
+		key = strings.ToLower
+	}
+
+	occurrences := make(map[string]int)
+	for _, v := range values {
+		occurrences[key(v)]++
+	}
+
+	reserved := make(map[string]bool)
+	for _, r := range options.Reserved {
+		reserved[key(r)] = true
+	}
+
+	// keeps reports whether values[i] keeps its name
+	seen := make(map[string]bool)
+	keeps := make([]bool, len(values))
+	taken := make(map[string]bool)
+	for r := range reserved {
+		taken[r] = true
+	}
+	for i, v := range values {
+		k := key(v)
+		keeps[i] = !seen[k] && !reserved[k] && !(options.RenameFirst && occurrences[k] > 1)
+		seen[k] = true
+		if keeps[i] {
+			taken[k] = true
+		}
+	}
+
+	ret := make([]string, len(values))
+	renamed := make(map[int]string)
+	counts := make(map[string]int)
+	maxProbes := len(values) + len(reserved) + 1
+
+	for i, v := range values {
+		if keeps[i] {
+			ret[i] = v
+			continue
+		}
+
+		k := key(v)
+		c, ok := counts[k]
+		if !ok {
+			c = firstCount
+		}
+
+		name := naming(v, c)
+		for probes := 0; taken[key(name)]; probes++ {
+			if probes >= maxProbes {
+				return nil, nil, fmt.Errorf("%w: the naming strategy did not produce a free name for '%s', e.g., '%s'", ErrInvalidArgument, v, name)
+			}
+			c++
+			name = naming(v, c)
+		}
+
+		taken[key(name)] = true
+		counts[k] = c + 1
+		ret[i] = name
+		renamed[i] = name
+	}
+
+	return ret, renamed, nil
+}
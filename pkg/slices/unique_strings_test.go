@@ -0,0 +1,99 @@
+package slices
+
+import (
+	"errors"
+	"github.com/rbnbr/go-utility/pkg/consts"
+	"testing"
+)
+
+// TestMakeUniqueStrings_default
+// Tests that the default options behave like MakeUniqueStringSlice with suffix "_".
+func TestMakeUniqueStrings_default(t *testing.T) {
+	testSlice := []string{"hi", "hi", "Hodor", "Peter", "Pan", "Peter", "Rocco", "1", "1", "Peter", "0"}
+
+	expectedResult, _ := MakeUniqueStringSlice(testSlice, "_")
+	gotResult, gotRenamed, gotError := MakeUniqueStrings(testSlice, UniqueStringsOptions{})
+	if !errors.Is(gotError, ErrNil) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, ErrNil)
+	}
+
+	if !Equal(gotResult, expectedResult, func(a, b string) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	if len(gotRenamed) != 4 || gotRenamed[9] != "Peter_3" {
+		t.Errorf(consts.GotExpectedResultFmt, gotRenamed, map[int]string{1: "hi_2", 5: "Peter_2", 8: "1_2", 9: "Peter_3"})
+	}
+}
+
+// TestMakeUniqueStrings_probing
+// Tests that already suffixed elements and reserved names are skipped instead of failing.
+func TestMakeUniqueStrings_probing(t *testing.T) {
+	testSlice := []string{"a", "a", "a (2)", "b"}
+
+	expectedResult := []string{"a", "a (3)", "a (2)", "b (2)"}
+	gotResult, _, gotError := MakeUniqueStrings(testSlice, UniqueStringsOptions{
+		Naming:   ParenthesesNaming(),
+		Reserved: []string{"b"},
+	})
+	if !errors.Is(gotError, ErrNil) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, ErrNil)
+	}
+
+	if !Equal(gotResult, expectedResult, func(a, b string) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	// suffixes with special regex characters are no problem
+	expectedResult = []string{"x", "x.2", "x.1"}
+	gotResult, _, _ = MakeUniqueStrings([]string{"x", "x", "x.1"}, UniqueStringsOptions{Naming: SuffixNaming(".")})
+	if !Equal(gotResult, expectedResult, func(a, b string) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+}
+
+// TestMakeUniqueStrings_options
+// Tests zero padding, start-at-1 numbering with renaming of the first occurrence and case insensitivity.
+func TestMakeUniqueStrings_options(t *testing.T) {
+	testSlice := []string{"File", "file", "other", "FILE"}
+
+	expectedResult := []string{"File_001", "file_002", "other", "FILE_003"}
+	gotResult, gotRenamed, gotError := MakeUniqueStrings(testSlice, UniqueStringsOptions{
+		Naming:          ZeroPaddedNaming("_", 3),
+		FirstCount:      1,
+		RenameFirst:     true,
+		CaseInsensitive: true,
+	})
+	if !errors.Is(gotError, ErrNil) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, ErrNil)
+	}
+
+	if !Equal(gotResult, expectedResult, func(a, b string) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	if _, ok := gotRenamed[2]; ok || len(gotRenamed) != 3 {
+		t.Errorf(consts.GotExpectedResultFmt, gotRenamed, map[int]string{0: "File_001", 1: "file_002", 3: "FILE_003"})
+	}
+}
+
+// TestMakeUniqueStrings_invalid_naming
+// Tests that a naming strategy which ignores the count results in an error instead of an endless loop.
+func TestMakeUniqueStrings_invalid_naming(t *testing.T) {
+	_, _, gotError := MakeUniqueStrings([]string{"a", "a", "a"}, UniqueStringsOptions{
+		Naming: func(value string, count int) string {
+			return value + "_copy"
+		},
+	})
+	if !errors.Is(gotError, ErrInvalidArgument) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, ErrInvalidArgument)
+	}
+}
@@ -0,0 +1,136 @@
+package slices
+
+import (
+	"github.com/rbnbr/go-utility/pkg/consts"
+	"testing"
+)
+
+// TestUniqueBy
+// Tests that UniqueBy and UniqueByLast have the same semantics as UniqueFirst and UniqueLast.
+func TestUniqueBy(t *testing.T) {
+	testSlice := []float64{1.0, 2.0, 4.0, -1.0, -2.0, 5.0, 1.1, 2.1, 0.0, 1.2}
+	testPredicate := func(a, b float64) bool {
+		return int(a) == int(b)
+	}
+	testAccessor := func(a float64) int {
+		return int(a)
+	}
+	equal := func(a, b float64) bool {
+		return a == b
+	}
+
+	expectedResult := UniqueFirst(testSlice, testPredicate)
+	gotResult := UniqueBy(testSlice, testAccessor)
+	if !Equal(gotResult, expectedResult, equal) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	expectedResult = UniqueLast(testSlice, testPredicate)
+	gotResult = UniqueByLast(testSlice, testAccessor)
+	if !Equal(gotResult, expectedResult, equal) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+}
+
+// TestUniqueComparable
+// Tests UniqueComparable and UniqueComparableLast.
+func TestUniqueComparable(t *testing.T) {
+	testSlice := []string{"a", "b", "a", "c", "b"}
+
+	expectedResult := []string{"a", "b", "c"}
+	gotResult := UniqueComparable(testSlice)
+	if !Equal(gotResult, expectedResult, func(a, b string) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	gotResult = UniqueComparableLast(testSlice)
+	if !Equal(gotResult, expectedResult, func(a, b string) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+}
+
+// TestUniqueByDetailed
+// Tests the returned duplicates and index mapping of UniqueByDetailed for first and last occurrence.
+func TestUniqueByDetailed(t *testing.T) {
+	testSlice := []float64{1.0, 2.0, 1.1, 3.0, 2.1, 1.2}
+	testAccessor := func(a float64) int {
+		return int(a)
+	}
+	equalFloats := func(a, b float64) bool {
+		return a == b
+	}
+	equalInts := func(a, b int) bool {
+		return a == b
+	}
+
+	expectedUnique := []float64{1.0, 2.0, 3.0}
+	expectedDuplicates := []float64{1.1, 2.1, 1.2}
+	expectedMapping := []int{0, 1, 0, 2, 1, 0}
+
+	gotUnique, gotDuplicates, gotMapping := UniqueByDetailed(testSlice, testAccessor, true)
+	if !Equal(gotUnique, expectedUnique, equalFloats) {
+		t.Errorf(consts.GotExpectedResultFmt, gotUnique, expectedUnique)
+	}
+	if !Equal(gotDuplicates, expectedDuplicates, equalFloats) {
+		t.Errorf(consts.GotExpectedResultFmt, gotDuplicates, expectedDuplicates)
+	}
+	if !Equal(gotMapping, expectedMapping, equalInts) {
+		t.Errorf(consts.GotExpectedResultFmt, gotMapping, expectedMapping)
+	}
+
+	expectedUnique = []float64{1.2, 2.1, 3.0}
+	expectedDuplicates = []float64{1.0, 2.0, 1.1}
+
+	gotUnique, gotDuplicates, gotMapping = UniqueByDetailed(testSlice, testAccessor, false)
+	if !Equal(gotUnique, expectedUnique, equalFloats) {
+		t.Errorf(consts.GotExpectedResultFmt, gotUnique, expectedUnique)
+	}
+	if !Equal(gotDuplicates, expectedDuplicates, equalFloats) {
+		t.Errorf(consts.GotExpectedResultFmt, gotDuplicates, expectedDuplicates)
+	}
+	if !Equal(gotMapping, expectedMapping, equalInts) {
+		t.Errorf(consts.GotExpectedResultFmt, gotMapping, expectedMapping)
+	}
+}
+
+// benchmarkUniqueSlice
+// Returns a slice of n integers with roughly n/2 distinct values.
+func benchmarkUniqueSlice(n int) []int {
+	slice := make([]int, n)
+	for i := range slice {
+		slice[i] = (i * 7919) % (n / 2)
+	}
+	return slice
+}
+
+func BenchmarkUniqueConfigurable(b *testing.B) {
+	slice := benchmarkUniqueSlice(2000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		UniqueConfigurable(slice, func(a, b int) bool {
+			return a == b
+		}, true)
+	}
+}
+
+func BenchmarkUniqueComparable(b *testing.B) {
+	slice := benchmarkUniqueSlice(2000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		UniqueComparable(slice)
+	}
+}
+
+func BenchmarkUniqueBy(b *testing.B) {
+	slice := benchmarkUniqueSlice(2000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		UniqueBy(slice, func(a int) int {
+			return a
+		})
+	}
+}
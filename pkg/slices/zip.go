@@ -0,0 +1,198 @@
+package slices
+
+import "fmt"
+
+// Pair
+// Generic tuple of two values.
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+// MakePair
+// Returns a Pair of a and b.
+func MakePair[A any, B any](a A, b B) Pair[A, B] {
+	return Pair[A, B]{First: a, Second: b}
+}
+
+// Triple
+// Generic tuple of three values.
+type Triple[A any, B any, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// MakeTriple
+// Returns a Triple of a, b and c.
+func MakeTriple[A any, B any, C any](a A, b B, c C) Triple[A, B, C] {
+	return Triple[A, B, C]{First: a, Second: b, Third: c}
+}
+
+// LengthMismatch
+// Configures how the zip functions handle slices of different length.
+type LengthMismatch int
+
+const (
+	MismatchTruncate LengthMismatch = iota // stop at the end of the shortest slice
+	MismatchPad                            // continue until the end of the longest slice, filling up missing elements with zero values
+	MismatchError                          // return ErrInvalidArgument
+)
+
+// zipLength
+// Returns the length of the zipped result for slices of the provided lengths according to mismatch.
+func zipLength(mismatch LengthMismatch, lengths ...int) (int, error) {
+	shortest, longest := lengths[0], lengths[0]
+	for _, l := range lengths[1:] {
+		if l < shortest {
+			shortest = l
+		}
+		if l > longest {
+			longest = l
+		}
+	}
+
+	switch mismatch {
+	case MismatchTruncate:
+		return shortest, nil
+	case MismatchPad:
+		return longest, nil
+	case MismatchError:
+		if shortest != longest {
+			return 0, fmt.Errorf("%w: slices have different lengths %v", ErrInvalidArgument, lengths)
+		}
+		return shortest, nil
+	default:
+		return 0, fmt.Errorf("%w: unknown length mismatch mode %d", ErrInvalidArgument, mismatch)
+	}
+}
+
+// elementOr
+// Returns slice[i] if i is a valid index, else fill.
+func elementOr[T any](slice []T, i int, fill T) T {
+	if i < len(slice) {
+		return slice[i]
+	}
+	return fill
+}
+
+// ZipWithConfigurable
+// Combines the elements of a and b at the same index using the combine function.
+// Slices of different length are handled according to mismatch.
+func ZipWithConfigurable[A any, B any, V any](a []A, b []B, combine func(A, B) V, mismatch LengthMismatch) ([]V, error) {
+	var (
+		zeroA A
+		zeroB B
+	)
+
+	n, err := zipLength(mismatch, len(a), len(b))
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]V, n)
+	for i := 0; i < n; i++ {
+		ret[i] = combine(elementOr(a, i, zeroA), elementOr(b, i, zeroB))
+	}
+
+	return ret, nil
+}
+
+// ZipWith
+// Combines the elements of a and b at the same index using the combine function.
+// Stops at the end of the shorter slice.
+func ZipWith[A any, B any, V any](a []A, b []B, combine func(A, B) V) []V {
+	ret, _ := ZipWithConfigurable(a, b, combine, MismatchTruncate)
+	return ret
+}
+
+// ZipConfigurable
+// Returns a slice of pairs of the elements of a and b at the same index.
+// Slices of different length are handled according to mismatch.
+func ZipConfigurable[A any, B any](a []A, b []B, mismatch LengthMismatch) ([]Pair[A, B], error) {
+	return ZipWithConfigurable(a, b, MakePair[A, B], mismatch)
+}
+
+// Zip
+// Returns a slice of pairs of the elements of a and b at the same index.
+// Stops at the end of the shorter slice.
+// Example:
+// Zip([]int{1, 2, 3}, []string{"a", "b"}) -> [{1 a} {2 b}]
+func Zip[A any, B any](a []A, b []B) []Pair[A, B] {
+	return ZipWith(a, b, MakePair[A, B])
+}
+
+// ZipLongest
+// Returns a slice of pairs of the elements of a and b at the same index.
+// Continues until the end of the longer slice, using fillA and fillB for missing elements.
+func ZipLongest[A any, B any](a []A, b []B, fillA A, fillB B) []Pair[A, B] {
+	n, _ := zipLength(MismatchPad, len(a), len(b))
+
+	ret := make([]Pair[A, B], n)
+	for i := 0; i < n; i++ {
+		ret[i] = MakePair(elementOr(a, i, fillA), elementOr(b, i, fillB))
+	}
+
+	return ret
+}
+
+// Zip3Configurable
+// Returns a slice of triples of the elements of a, b and c at the same index.
+// Slices of different length are handled according to mismatch.
+func Zip3Configurable[A any, B any, C any](a []A, b []B, c []C, mismatch LengthMismatch) ([]Triple[A, B, C], error) {
+	var (
+		zeroA A
+		zeroB B
+		zeroC C
+	)
+
+	n, err := zipLength(mismatch, len(a), len(b), len(c))
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]Triple[A, B, C], n)
+	for i := 0; i < n; i++ {
+		ret[i] = MakeTriple(elementOr(a, i, zeroA), elementOr(b, i, zeroB), elementOr(c, i, zeroC))
+	}
+
+	return ret, nil
+}
+
+// Zip3
+// Returns a slice of triples of the elements of a, b and c at the same index.
+// Stops at the end of the shortest slice.
+func Zip3[A any, B any, C any](a []A, b []B, c []C) []Triple[A, B, C] {
+	ret, _ := Zip3Configurable(a, b, c, MismatchTruncate)
+	return ret
+}
+
+// Unzip
+// Splits a slice of pairs into a slice of the first and a slice of the second elements.
+func Unzip[A any, B any](pairs []Pair[A, B]) ([]A, []B) {
+	a := make([]A, len(pairs))
+	b := make([]B, len(pairs))
+
+	for i := range pairs {
+		a[i] = pairs[i].First
+		b[i] = pairs[i].Second
+	}
+
+	return a, b
+}
+
+// Unzip3
+// Splits a slice of triples into a slice of the first, a slice of the second and a slice of the third elements.
+func Unzip3[A any, B any, C any](triples []Triple[A, B, C]) ([]A, []B, []C) {
+	a := make([]A, len(triples))
+	b := make([]B, len(triples))
+	c := make([]C, len(triples))
+
+	for i := range triples {
+		a[i] = triples[i].First
+		b[i] = triples[i].Second
+		c[i] = triples[i].Third
+	}
+
+	return a, b, c
+}
@@ -0,0 +1,74 @@
+package slices
+
+import (
+	"errors"
+	"github.com/rbnbr/go-utility/pkg/consts"
+	"testing"
+)
+
+// TestZip
+// Tests Zip, ZipLongest and the configurable length mismatch handling.
+func TestZip(t *testing.T) {
+	testA := []int{1, 2, 3}
+	testB := []string{"a", "b"}
+	equal := func(p1 Pair[int, string], p2 Pair[int, string]) bool {
+		return p1 == p2
+	}
+
+	expectedResult := []Pair[int, string]{{1, "a"}, {2, "b"}}
+	gotResult := Zip(testA, testB)
+	if !Equal(gotResult, expectedResult, equal) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	expectedResult = []Pair[int, string]{{1, "a"}, {2, "b"}, {3, ""}}
+	gotResult, gotError := ZipConfigurable(testA, testB, MismatchPad)
+	if !errors.Is(gotError, ErrNil) || !Equal(gotResult, expectedResult, equal) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+
+	_, gotError = ZipConfigurable(testA, testB, MismatchError)
+	if !errors.Is(gotError, ErrInvalidArgument) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, ErrInvalidArgument)
+	}
+
+	expectedResult = []Pair[int, string]{{1, "a"}, {2, "b"}, {3, "?"}}
+	gotResult = ZipLongest(testA, testB, -1, "?")
+	if !Equal(gotResult, expectedResult, equal) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+}
+
+// TestZipWith
+// Tests the ZipWith function.
+func TestZipWith(t *testing.T) {
+	expectedResult := []int{11, 22}
+	gotResult := ZipWith([]int{1, 2, 3}, []int{10, 20}, func(a int, b int) int {
+		return a + b
+	})
+	if !Equal(gotResult, expectedResult, func(a, b int) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedResult)
+	}
+}
+
+// TestUnzip
+// Tests that Unzip and Unzip3 reverse Zip and Zip3.
+func TestUnzip(t *testing.T) {
+	testA := []int{1, 2}
+	testB := []string{"a", "b"}
+	testC := []bool{true, false}
+
+	gotA, gotB := Unzip(Zip(testA, testB))
+	if !Equal(gotA, testA, func(a, b int) bool { return a == b }) || !Equal(gotB, testB, func(a, b string) bool { return a == b }) {
+		t.Errorf(consts.GotExpectedResultFmt, []interface{}{gotA, gotB}, []interface{}{testA, testB})
+	}
+
+	gotA, gotB, gotC := Unzip3(Zip3(testA, testB, testC))
+	if !Equal(gotA, testA, func(a, b int) bool { return a == b }) ||
+		!Equal(gotB, testB, func(a, b string) bool { return a == b }) ||
+		!Equal(gotC, testC, func(a, b bool) bool { return a == b }) {
+		t.Errorf(consts.GotExpectedResultFmt, []interface{}{gotA, gotB, gotC}, []interface{}{testA, testB, testC})
+	}
+}
@@ -0,0 +1,364 @@
+package stats
+
+import (
+	"fmt"
+	"github.com/rbnbr/go-utility/pkg/slices"
+	"math"
+	"sort"
+)
+
+var (
+	ErrInvalidArgument = slices.ErrInvalidArgument // there has been a problem with the provided argument, e.g., an empty slice
+)
+
+// Interpolation
+// Configures how Percentile computes a value that lies between two data points.
+type Interpolation int
+
+const (
+	InterpolationLinear   Interpolation = iota // linear interpolation between the two data points
+	InterpolationLower                         // the lower data point
+	InterpolationHigher                        // the higher data point
+	InterpolationNearest                       // the nearest data point, the lower one on ties
+	InterpolationMidpoint                      // the mean of the two data points
+)
+
+// Histogram
+// Counts of the values falling into each bin.
+// Bin i covers [Edges[i], Edges[i+1]), except for the last bin which also includes its right edge.
+type Histogram struct {
+	Edges  []float64
+	Counts []int
+}
+
+// errEmpty
+// Returns the error for functions that are undefined on empty slices.
+func errEmpty(function string) error {
+	return fmt.Errorf("%w: %s of an empty slice is undefined", ErrInvalidArgument, function)
+}
+
+// Sum
+// Returns the sum of all values using plain addition, i.e., in the type of the values.
+// See KahanSum for a numerically stable alternative for floating point values.
+func Sum[N slices.Number](slice []N) N {
+	var sum N
+	for _, v := range slice {
+		sum += v
+	}
+	return sum
+}
+
+// KahanSum
+// Returns the sum of all values using Kahan-Babuska (Neumaier) summation,
+// which keeps track of the rounding error and is therefore numerically stable.
+func KahanSum[N slices.Number](slice []N) float64 {
+	sum, compensation := 0.0, 0.0
+	for _, n := range slice {
+		v := float64(n)
+		t := sum + v
+		if math.Abs(sum) >= math.Abs(v) {
+			compensation += (sum - t) + v
+		} else {
+			compensation += (v - t) + sum
+		}
+		sum = t
+	}
+	return sum + compensation
+}
+
+// Mean
+// Returns the arithmetic mean of all values, summed up using KahanSum.
+// Returns ErrInvalidArgument if slice is empty.
+func Mean[N slices.Number](slice []N) (float64, error) {
+	if len(slice) == 0 {
+		return 0, errEmpty("mean")
+	}
+	return KahanSum(slice) / float64(len(slice)), nil
+}
+
+// Min
+// Returns the smallest value.
+// Comparisons with NaN are false, i.e., the result is NaN if the first value is NaN and later NaN values are ignored.
+// Returns ErrInvalidArgument if slice is empty.
+func Min[N slices.Number](slice []N) (N, error) {
+	if len(slice) == 0 {
+		return 0, errEmpty("min")
+	}
+	ret := slice[0]
+	for _, v := range slice[1:] {
+		if v < ret {
+			ret = v
+		}
+	}
+	return ret, nil
+}
+
+// Max
+// Returns the largest value.
+// Comparisons with NaN are false, i.e., the result is NaN if the first value is NaN and later NaN values are ignored.
+// Returns ErrInvalidArgument if slice is empty.
+func Max[N slices.Number](slice []N) (N, error) {
+	if len(slice) == 0 {
+		return 0, errEmpty("max")
+	}
+	ret := slice[0]
+	for _, v := range slice[1:] {
+		if v > ret {
+			ret = v
+		}
+	}
+	return ret, nil
+}
+
+// Median
+// Returns the median, i.e., the 50th percentile with linear interpolation.
+// NaN values are handled as described for Percentile.
+// Returns ErrInvalidArgument if slice is empty.
+func Median[N slices.Number](slice []N) (float64, error) {
+	if len(slice) == 0 {
+		return 0, errEmpty("median")
+	}
+	return Percentile(slice, 50, InterpolationLinear)
+}
+
+// Mode
+// Returns the most frequent values in the order of their first occurrence, i.e., more than one value if there is a tie.
+// Returns ErrInvalidArgument if slice is empty.
+func Mode[N slices.Number](slice []N) ([]N, error) {
+	if len(slice) == 0 {
+		return nil, errEmpty("mode")
+	}
+
+	counts := slices.CountBy(slice, func(v N) N {
+		return v
+	})
+	highest := 0
+	for _, c := range counts {
+		if c > highest {
+			highest = c
+		}
+	}
+
+	return slices.Filter(slices.UniqueComparable(slice), func(v N) bool {
+		return counts[v] == highest
+	}), nil
+}
+
+// Percentile
+// Returns the p-th percentile, p in [0, 100], of the values.
+// If the percentile lies between two data points, the result depends on the interpolation method.
+// NaN values are sorted before all other values as done by sort.Float64s, i.e., they count as the smallest values.
+// Does not modify the provided slice.
+// Returns ErrInvalidArgument if slice is empty, p is out of range, or interpolation is unknown.
+func Percentile[N slices.Number](slice []N, p float64, interpolation Interpolation) (float64, error) {
+	if len(slice) == 0 {
+		return 0, errEmpty("percentile")
+	}
+	if p < 0 || p > 100 || math.IsNaN(p) {
+		return 0, fmt.Errorf("%w: percentile has to be in [0, 100] but is %v", ErrInvalidArgument, p)
+	}
+
+	sorted := make([]float64, len(slice))
+	for i, v := range slice {
+		sorted[i] = float64(v)
+	}
+	sort.Float64s(sorted)
+
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	higher := int(math.Ceil(rank))
+	fraction := rank - float64(lower)
+
+	switch interpolation {
+	case InterpolationLinear:
+		return sorted[lower] + fraction*(sorted[higher]-sorted[lower]), nil
+	case InterpolationLower:
+		return sorted[lower], nil
+	case InterpolationHigher:
+		return sorted[higher], nil
+	case InterpolationNearest:
+		if fraction > 0.5 {
+			return sorted[higher], nil
+		}
+		return sorted[lower], nil
+	case InterpolationMidpoint:
+		return (sorted[lower] + sorted[higher]) / 2, nil
+	default:
+		return 0, fmt.Errorf("%w: unknown interpolation method %d", ErrInvalidArgument, interpolation)
+	}
+}
+
+// welford
+// Returns the mean and the sum of squared differences from the mean using Welford's numerically stable online algorithm.
+func welford[N slices.Number](slice []N) (float64, float64) {
+	mean, m2 := 0.0, 0.0
+	for i, n := range slice {
+		v := float64(n)
+		delta := v - mean
+		mean += delta / float64(i+1)
+		m2 += delta * (v - mean)
+	}
+	return mean, m2
+}
+
+// VarianceConfigurable
+// Returns the variance of the values, computed with Welford's algorithm.
+// If sample is true, returns the sample variance (divided by n-1), else the population variance (divided by n).
+// Returns ErrInvalidArgument if slice is empty, or contains only one value for the sample variance.
+func VarianceConfigurable[N slices.Number](slice []N, sample bool) (float64, error) {
+	if len(slice) == 0 {
+		return 0, errEmpty("variance")
+	}
+
+	_, m2 := welford(slice)
+	if !sample {
+		return m2 / float64(len(slice)), nil
+	}
+
+	if len(slice) < 2 {
+		return 0, fmt.Errorf("%w: sample variance requires at least two values", ErrInvalidArgument)
+	}
+	return m2 / float64(len(slice)-1), nil
+}
+
+// Variance
+// Shorthand for VarianceConfigurable(slice, false), i.e., the population variance.
+func Variance[N slices.Number](slice []N) (float64, error) {
+	return VarianceConfigurable(slice, false)
+}
+
+// SampleVariance
+// Shorthand for VarianceConfigurable(slice, true)
+func SampleVariance[N slices.Number](slice []N) (float64, error) {
+	return VarianceConfigurable(slice, true)
+}
+
+// StdDev
+// Returns the population standard deviation, i.e., the square root of Variance.
+func StdDev[N slices.Number](slice []N) (float64, error) {
+	v, err := Variance(slice)
+	return math.Sqrt(v), err
+}
+
+// SampleStdDev
+// Returns the sample standard deviation, i.e., the square root of SampleVariance.
+func SampleStdDev[N slices.Number](slice []N) (float64, error) {
+	v, err := SampleVariance(slice)
+	return math.Sqrt(v), err
+}
+
+// HistogramWithEdges
+// Counts the values falling into the bins defined by the provided ascending edges, see Histogram.
+// NaN values and values outside of [edges[0], edges[len(edges)-1]] are ignored.
+// Returns ErrInvalidArgument if there are less than two edges or they are not strictly ascending.
+func HistogramWithEdges[N slices.Number](slice []N, edges []float64) (Histogram, error) {
+	if len(edges) < 2 {
+		return Histogram{}, fmt.Errorf("%w: a histogram requires at least two edges", ErrInvalidArgument)
+	}
+	for i := 1; i < len(edges); i++ {
+		if !(edges[i-1] < edges[i]) {
+			return Histogram{}, fmt.Errorf("%w: histogram edges have to be strictly ascending", ErrInvalidArgument)
+		}
+	}
+
+	return histogram(slice, edges), nil
+}
+
+// histogram
+// Same as HistogramWithEdges but without validating the edges.
+// Bins whose edges are equal stay empty.
+func histogram[N slices.Number](slice []N, edges []float64) Histogram {
+	last := len(edges) - 1
+	counts := make([]int, last)
+	for _, n := range slice {
+		v := float64(n)
+		if math.IsNaN(v) || v < edges[0] || v > edges[last] {
+			continue
+		}
+		// index of the first edge greater than v, the bin is the one before it
+		bin := sort.SearchFloat64s(edges, math.Nextafter(v, math.Inf(1))) - 1
+		if bin == last {
+			bin--
+		}
+		counts[bin]++
+	}
+
+	return Histogram{Edges: append([]float64(nil), edges...), Counts: counts}
+}
+
+// EqualWidthHistogram
+// Counts the values falling into bins equally sized bins spanning from the smallest to the largest value.
+// If the range of the values is tiny compared to their magnitude, adjacent edges may be equal due to the limited
+// precision of float64. Such bins stay empty and the values are counted in the next bin of positive width.
+// NaN values are ignored, both for the range of the bins and for the counts.
+// Returns ErrInvalidArgument if slice is empty, contains only NaN values, or bins is smaller than 1.
+func EqualWidthHistogram[N slices.Number](slice []N, bins int) (Histogram, error) {
+	if bins < 1 {
+		return Histogram{}, fmt.Errorf("%w: a histogram requires at least one bin but got %d", ErrInvalidArgument, bins)
+	}
+
+	if len(slice) == 0 {
+		return Histogram{}, errEmpty("histogram")
+	}
+
+	low, high := math.Inf(1), math.Inf(-1)
+	for _, n := range slice {
+		v := float64(n)
+		if math.IsNaN(v) {
+			continue
+		}
+		if v < low {
+			low = v
+		}
+		if v > high {
+			high = v
+		}
+	}
+	if low > high {
+		return Histogram{}, fmt.Errorf("%w: a histogram requires at least one value which is not NaN", ErrInvalidArgument)
+	}
+	if low == high {
+		// all values are equal, use a bin of width 1 around them
+		low, high = low-0.5, high+0.5
+	}
+
+	edges := make([]float64, bins+1)
+	for i := range edges {
+		edges[i] = low + (high-low)*float64(i)/float64(bins)
+	}
+	edges[bins] = high
+
+	return histogram(slice, edges), nil
+}
+
+// SumBy
+// Returns the sum of the values got via the accessor function for every element.
+func SumBy[V any, N slices.Number](slice []V, accessor func(v V) N) N {
+	return Sum(slices.Map(slice, func(v *V) N {
+		return accessor(*v)
+	}))
+}
+
+// MeanBy
+// Returns the mean of the values got via the accessor function for every element, see Mean.
+func MeanBy[V any, N slices.Number](slice []V, accessor func(v V) N) (float64, error) {
+	return Mean(slices.Map(slice, func(v *V) N {
+		return accessor(*v)
+	}))
+}
+
+// VarianceBy
+// Returns the variance of the values got via the accessor function for every element, see VarianceConfigurable.
+func VarianceBy[V any, N slices.Number](slice []V, accessor func(v V) N, sample bool) (float64, error) {
+	return VarianceConfigurable(slices.Map(slice, func(v *V) N {
+		return accessor(*v)
+	}), sample)
+}
+
+// PercentileBy
+// Returns the p-th percentile of the values got via the accessor function for every element, see Percentile.
+func PercentileBy[V any, N slices.Number](slice []V, accessor func(v V) N, p float64, interpolation Interpolation) (float64, error) {
+	return Percentile(slices.Map(slice, func(v *V) N {
+		return accessor(*v)
+	}), p, interpolation)
+}
@@ -0,0 +1,235 @@
+package stats
+
+import (
+	"errors"
+	"github.com/rbnbr/go-utility/pkg/consts"
+	"github.com/rbnbr/go-utility/pkg/slices"
+	"math"
+	"testing"
+)
+
+// almostEqual
+// Returns true if a and b differ by less than 1e-9.
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+// TestSum
+// Tests Sum and that KahanSum is more precise than the plain sum.
+func TestSum(t *testing.T) {
+	gotResult := Sum([]int{1, 2, 3, 4})
+	if gotResult != 10 {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, 10)
+	}
+
+	testSlice := []float64{1, 1e100, 1, -1e100}
+	expectedResult := 2.0
+	gotKahan := KahanSum(testSlice)
+	if gotKahan != expectedResult {
+		t.Errorf(consts.GotExpectedResultFmt, gotKahan, expectedResult)
+	}
+}
+
+// TestMeanMedianMode
+// Tests Mean, Median, Mode, Min and Max including the empty slice error.
+func TestMeanMedianMode(t *testing.T) {
+	testSlice := []int{3, 1, 4, 1, 5, 9, 2, 6, 5}
+
+	gotMean, _ := Mean(testSlice)
+	if !almostEqual(gotMean, 4) {
+		t.Errorf(consts.GotExpectedResultFmt, gotMean, 4)
+	}
+
+	gotMedian, _ := Median(testSlice)
+	if gotMedian != 4 {
+		t.Errorf(consts.GotExpectedResultFmt, gotMedian, 4)
+	}
+
+	gotMedian, _ = Median([]float64{1, 2, 3, 4})
+	if gotMedian != 2.5 {
+		t.Errorf(consts.GotExpectedResultFmt, gotMedian, 2.5)
+	}
+
+	expectedMode := []int{1, 5}
+	gotMode, _ := Mode(testSlice)
+	if !slices.Equal(gotMode, expectedMode, func(a, b int) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotMode, expectedMode)
+	}
+
+	gotMin, _ := Min(testSlice)
+	gotMax, _ := Max(testSlice)
+	if gotMin != 1 || gotMax != 9 {
+		t.Errorf(consts.GotExpectedResultFmt, []int{gotMin, gotMax}, []int{1, 9})
+	}
+
+	_, gotError := Mean([]int{})
+	if !errors.Is(gotError, ErrInvalidArgument) || !errors.Is(gotError, slices.ErrInvalidArgument) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, ErrInvalidArgument)
+	}
+}
+
+// TestPercentile
+// Tests the interpolation methods of Percentile.
+func TestPercentile(t *testing.T) {
+	testSlice := []int{40, 10, 30, 20}
+
+	testCases := []struct {
+		interpolation Interpolation
+		expected      float64
+	}{
+		{InterpolationLinear, 17.5},
+		{InterpolationLower, 10},
+		{InterpolationHigher, 20},
+		{InterpolationNearest, 20},
+		{InterpolationMidpoint, 15},
+	}
+
+	for _, tc := range testCases {
+		gotResult, gotError := Percentile(testSlice, 25, tc.interpolation)
+		if !errors.Is(gotError, nil) || !almostEqual(gotResult, tc.expected) {
+			t.Errorf(consts.GotExpectedResultFmt, gotResult, tc.expected)
+		}
+	}
+
+	_, gotError := Percentile(testSlice, 101, InterpolationLinear)
+	if !errors.Is(gotError, ErrInvalidArgument) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, ErrInvalidArgument)
+	}
+}
+
+// TestVariance
+// Tests population and sample variance and standard deviation.
+func TestVariance(t *testing.T) {
+	testSlice := []float64{2, 4, 4, 4, 5, 5, 7, 9}
+
+	gotVariance, _ := Variance(testSlice)
+	gotStdDev, _ := StdDev(testSlice)
+	if !almostEqual(gotVariance, 4) || !almostEqual(gotStdDev, 2) {
+		t.Errorf(consts.GotExpectedResultFmt, []float64{gotVariance, gotStdDev}, []float64{4, 2})
+	}
+
+	gotVariance, _ = SampleVariance(testSlice)
+	if !almostEqual(gotVariance, 32.0/7) {
+		t.Errorf(consts.GotExpectedResultFmt, gotVariance, 32.0/7)
+	}
+
+	// large offsets do not destroy the precision
+	shifted := slices.Map(testSlice, func(v *float64) float64 {
+		return *v + 1e9
+	})
+	gotVariance, _ = Variance(shifted)
+	if math.Abs(gotVariance-4) > 1e-6 {
+		t.Errorf(consts.GotExpectedResultFmt, gotVariance, 4)
+	}
+
+	_, gotError := SampleStdDev([]int{1})
+	if !errors.Is(gotError, ErrInvalidArgument) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, ErrInvalidArgument)
+	}
+}
+
+// TestHistogram
+// Tests equal width bins and custom edges.
+func TestHistogram(t *testing.T) {
+	testSlice := []float64{0, 1, 2, 2.5, 3, 4}
+	equal := func(a, b int) bool {
+		return a == b
+	}
+
+	expectedCounts := []int{2, 2, 2}
+	gotResult, _ := EqualWidthHistogram(testSlice, 3)
+	if !slices.Equal(gotResult.Counts, expectedCounts, equal) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedCounts)
+	}
+
+	expectedCounts = []int{1, 3}
+	gotResult, _ = HistogramWithEdges(testSlice, []float64{0.5, 2, 3})
+	if !slices.Equal(gotResult.Counts, expectedCounts, equal) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedCounts)
+	}
+
+	_, gotError := HistogramWithEdges(testSlice, []float64{1, 1})
+	if !errors.Is(gotError, ErrInvalidArgument) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, ErrInvalidArgument)
+	}
+}
+
+// TestHistogram_NaN
+// Tests that NaN values are ignored instead of being counted in the last bin.
+func TestHistogram_NaN(t *testing.T) {
+	testSlice := []float64{0, 1, 2, math.NaN()}
+
+	expectedCounts := []int{1, 2}
+	gotResult, _ := HistogramWithEdges(testSlice, []float64{0, 1, 2})
+	if !slices.Equal(gotResult.Counts, expectedCounts, func(a, b int) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult, expectedCounts)
+	}
+}
+
+// TestEqualWidthHistogram_largeMagnitude
+// Tests that values whose range is tiny compared to their magnitude are still counted.
+func TestEqualWidthHistogram_largeMagnitude(t *testing.T) {
+	testSlice := []float64{1e16, 1e16 + 2}
+
+	gotResult, gotError := EqualWidthHistogram(testSlice, 10)
+	if !errors.Is(gotError, slices.ErrNil) {
+		t.Fatalf(consts.GotExpectedErrorFmt, gotError, slices.ErrNil)
+	}
+
+	gotTotal := Sum(gotResult.Counts)
+	if gotTotal != len(testSlice) || gotResult.Counts[9] != 1 {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult.Counts, "both values counted, the largest in the last bin")
+	}
+}
+
+// TestEqualWidthHistogram_NaN
+// Tests that NaN values, also leading ones, are ignored for the bin range and that only NaN values are rejected.
+func TestEqualWidthHistogram_NaN(t *testing.T) {
+	testSlice := []float64{math.NaN(), 1, 2, 3}
+
+	gotResult, gotError := EqualWidthHistogram(testSlice, 3)
+	if !errors.Is(gotError, slices.ErrNil) {
+		t.Fatalf(consts.GotExpectedErrorFmt, gotError, slices.ErrNil)
+	}
+
+	expectedResult := []int{1, 1, 1}
+	if !slices.Equal(gotResult.Counts, expectedResult, func(a, b int) bool {
+		return a == b
+	}) {
+		t.Errorf(consts.GotExpectedResultFmt, gotResult.Counts, expectedResult)
+	}
+
+	_, gotError = EqualWidthHistogram([]float64{math.NaN(), math.NaN()}, 3)
+	if !errors.Is(gotError, ErrInvalidArgument) {
+		t.Errorf(consts.GotExpectedErrorFmt, gotError, ErrInvalidArgument)
+	}
+}
+
+// TestBy
+// Tests the accessor based variants.
+func TestBy(t *testing.T) {
+	type item struct {
+		name  string
+		price float64
+	}
+	testSlice := []item{{"a", 1.5}, {"b", 2.5}, {"c", 5}}
+	accessor := func(i item) float64 {
+		return i.price
+	}
+
+	gotSum := SumBy(testSlice, accessor)
+	gotMean, _ := MeanBy(testSlice, accessor)
+	gotMedian, _ := PercentileBy(testSlice, accessor, 50, InterpolationLinear)
+	if gotSum != 9 || gotMean != 3 || gotMedian != 2.5 {
+		t.Errorf(consts.GotExpectedResultFmt, []float64{gotSum, gotMean, gotMedian}, []float64{9, 3, 2.5})
+	}
+
+	gotVariance, _ := VarianceBy(testSlice, accessor, false)
+	if !almostEqual(gotVariance, (2.25+0.25+4)/3) {
+		t.Errorf(consts.GotExpectedResultFmt, gotVariance, (2.25+0.25+4)/3)
+	}
+}